@@ -18,6 +18,7 @@ const (
 type DialectQuerySet interface {
 	GetTablesMetaData(db *sql.DB, schemaName string, tableType TableType) []Table
 	GetEnumsMetaData(db *sql.DB, schemaName string) []Enum
+	GetSequencesMetaData(db *sql.DB, schemaName string) []Sequence
 }
 
 // GetSchema retrieves Schema information from database
@@ -27,10 +28,12 @@ func GetSchema(db *sql.DB, querySet DialectQuerySet, schemaName string) Schema {
 		TablesMetaData: querySet.GetTablesMetaData(db, schemaName, BaseTable),
 		ViewsMetaData:  querySet.GetTablesMetaData(db, schemaName, ViewTable),
 		EnumsMetaData:  querySet.GetEnumsMetaData(db, schemaName),
+
+		SequencesMetaData: querySet.GetSequencesMetaData(db, schemaName),
 	}
 
 	fmt.Println("	FOUND", len(ret.TablesMetaData), "table(s),", len(ret.ViewsMetaData), "view(s),",
-		len(ret.EnumsMetaData), "enum(s)")
+		len(ret.EnumsMetaData), "enum(s),", len(ret.SequencesMetaData), "sequence(s)")
 
 	return ret
 }
@@ -6,9 +6,12 @@ type Schema struct {
 	TablesMetaData []Table
 	ViewsMetaData  []Table
 	EnumsMetaData  []Enum
+
+	SequencesMetaData []Sequence
 }
 
-// IsEmpty returns true if schema info does not contain any table, views or enums metadata
+// IsEmpty returns true if schema info does not contain any table, views, enums or sequences metadata
 func (s Schema) IsEmpty() bool {
-	return len(s.TablesMetaData) == 0 && len(s.ViewsMetaData) == 0 && len(s.EnumsMetaData) == 0
+	return len(s.TablesMetaData) == 0 && len(s.ViewsMetaData) == 0 && len(s.EnumsMetaData) == 0 &&
+		len(s.SequencesMetaData) == 0
 }
@@ -0,0 +1,6 @@
+package metadata
+
+// Sequence metadata struct
+type Sequence struct {
+	Name string `sql:"primary_key"`
+}
@@ -20,3 +20,16 @@ func (t Table) MutableColumns() []Column {
 
 	return ret
 }
+
+// PrimaryKeyColumns returns list of table primary key columns
+func (t Table) PrimaryKeyColumns() []Column {
+	var ret []Column
+
+	for _, column := range t.Columns {
+		if column.IsPrimaryKey {
+			ret = append(ret, column)
+		}
+	}
+
+	return ret
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/internal/utils"
 	"github.com/go-jet/jet/v2/internal/utils/throw"
 	"github.com/go-jet/jet/v2/qrm"
 )
@@ -59,9 +60,43 @@ ORDER BY ordinal_position;
 	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, tableName, schemaName, tableName}, &columns)
 	throw.OnError(err)
 
+	if isMariaDB(db) {
+		setMariaDBJsonColumns(db, schemaName, tableName, columns)
+	}
+
 	return columns
 }
 
+func isMariaDB(db *sql.DB) bool {
+	var version string
+
+	err := db.QueryRow("SELECT VERSION()").Scan(&version)
+	throw.OnError(err)
+
+	return strings.Contains(strings.ToLower(version), "mariadb")
+}
+
+// setMariaDBJsonColumns sets data type name of MariaDB json columns to json. In MariaDB json is an alias for longtext,
+// and json columns are reported as longtext columns with json_valid check constraint.
+func setMariaDBJsonColumns(db *sql.DB, schemaName, tableName string, columns []metadata.Column) {
+	query := `
+SELECT CONSTRAINT_NAME
+FROM information_schema.CHECK_CONSTRAINTS
+WHERE CONSTRAINT_SCHEMA = ? AND TABLE_NAME = ? AND
+	REPLACE(CHECK_CLAUSE, CHAR(96), '') = CONCAT('json_valid(', CONSTRAINT_NAME, ')');
+`
+	var jsonColumns []string
+
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, tableName}, &jsonColumns)
+	throw.OnError(err)
+
+	for i, column := range columns {
+		if column.DataType.Name == "longtext" && utils.StringSliceContains(jsonColumns, column.Name) {
+			columns[i].DataType.Name = "json"
+		}
+	}
+}
+
 func (m *mySqlQuerySet) GetEnumsMetaData(db *sql.DB, schemaName string) []metadata.Enum {
 	query := `
 SELECT (CASE c.DATA_TYPE WHEN 'enum' then CONCAT(c.TABLE_NAME, '_', c.COLUMN_NAME) ELSE '' END ) as "name", 
@@ -91,3 +126,19 @@ WHERE c.table_schema = ? AND DATA_TYPE = 'enum';
 
 	return ret
 }
+
+// GetSequencesMetaData returns MariaDB sequences. MySQL does not support sequences.
+func (m *mySqlQuerySet) GetSequencesMetaData(db *sql.DB, schemaName string) []metadata.Sequence {
+	query := `
+SELECT table_name as "sequence.name"
+FROM information_schema.tables
+WHERE table_schema = ? AND table_type = 'SEQUENCE'
+ORDER BY table_name;`
+
+	var result []metadata.Sequence
+
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName}, &result)
+	throw.OnError(err)
+
+	return result
+}
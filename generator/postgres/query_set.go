@@ -89,3 +89,18 @@ ORDER BY n.nspname, t.typname, e.enumsortorder;`
 
 	return result
 }
+
+func (p postgresQuerySet) GetSequencesMetaData(db *sql.DB, schemaName string) []metadata.Sequence {
+	query := `
+SELECT sequence_name as "sequence.name"
+FROM information_schema.sequences
+WHERE sequence_schema = $1
+ORDER BY sequence_name;`
+
+	var result []metadata.Sequence
+
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName}, &result)
+	throw.OnError(err)
+
+	return result
+}
@@ -78,3 +78,7 @@ func getColumnType(columnType string) string {
 func (p sqliteQuerySet) GetEnumsMetaData(db *sql.DB, schemaName string) []metadata.Enum {
 	return nil
 }
+
+func (p sqliteQuerySet) GetSequencesMetaData(db *sql.DB, schemaName string) []metadata.Sequence {
+	return nil
+}
@@ -80,10 +80,11 @@ func new{{tableTemplate.TypeName}}Impl(schemaName, tableName, alias string) {{st
 {{- end}}
 		allColumns     = {{dialect.PackageName}}.ColumnList{ {{template "column-list" .Columns}} }
 		mutableColumns = {{dialect.PackageName}}.ColumnList{ {{template "column-list" .MutableColumns}} }
+		primaryKeys    = {{dialect.PackageName}}.ColumnList{ {{template "column-list" .PrimaryKeyColumns}} }
 	)
 
 	return {{structImplName}}{
-		Table: {{dialect.PackageName}}.NewTable(schemaName, tableName, alias, allColumns...),
+		Table: {{dialect.PackageName}}.NewTableWithPrimaryKey(schemaName, tableName, alias, primaryKeys, allColumns...),
 
 		//Columns
 {{- range $i, $c := .Columns}}
@@ -103,7 +104,7 @@ var tableSqlBuilderSetSchemaTemplate = `package {{package}}
 // this method only once at the beginning of the program.
 func UseSchema(schema string) {
 {{- range .}}
-	{{ .InstanceName }} = {{ .InstanceName }}.FromSchema(schema)
+	{{ . }} = {{ . }}.FromSchema(schema)
 {{- end}}
 }
 `
@@ -143,6 +144,13 @@ var {{enumTemplate.InstanceName}} = &struct {
 }
 `
 
+var sequenceSQLBuilderTemplate = `package {{package}}
+
+import "github.com/go-jet/jet/v2/{{dialect.PackageName}}"
+
+var {{sequenceTemplate.InstanceName}} = {{dialect.PackageName}}.NewSequence("{{schemaName}}", "{{.Name}}")
+`
+
 var enumModelTemplate = `package {{package}}
 {{- $enumTemplate := enumTemplate}}
 
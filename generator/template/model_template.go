@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/go-jet/jet/v2/generator/metadata"
 	"github.com/go-jet/jet/v2/internal/utils"
+	"github.com/go-jet/jet/v2/postgres/pgrange"
 	"github.com/google/uuid"
 	"path"
 	"reflect"
@@ -307,7 +308,7 @@ func toGoType(column metadata.Column) interface{} {
 	case "text",
 		"character", "bpchar",
 		"character varying", "varchar", "nvarchar",
-		"tsvector", "bit", "bit varying", "varbit",
+		"tsvector", "tsquery", "bit", "bit varying", "varbit",
 		"money", "json", "jsonb",
 		"xml", "point", "interval", "line", "array",
 		"char", "tinytext", "mediumtext", "longtext": // MySQL
@@ -320,6 +321,30 @@ func toGoType(column metadata.Column) interface{} {
 		return float64(0.0)
 	case "uuid":
 		return uuid.UUID{}
+	case "int4range":
+		return pgrange.Int4Range{}
+	case "int8range":
+		return pgrange.Int8Range{}
+	case "numrange":
+		return pgrange.NumRange{}
+	case "tsrange":
+		return pgrange.TsRange{}
+	case "tstzrange":
+		return pgrange.TsTzRange{}
+	case "daterange":
+		return pgrange.DateRange{}
+	case "int4multirange":
+		return pgrange.Int4MultiRange{}
+	case "int8multirange":
+		return pgrange.Int8MultiRange{}
+	case "nummultirange":
+		return pgrange.NumMultiRange{}
+	case "tsmultirange":
+		return pgrange.TsMultiRange{}
+	case "tstzmultirange":
+		return pgrange.TsTzMultiRange{}
+	case "datemultirange":
+		return pgrange.DateMultiRange{}
 	default:
 		fmt.Println("- [Model      ] Unsupported sql column '" + column.Name + " " + column.DataType.Name + "', using string instead.")
 		return ""
@@ -42,4 +42,20 @@ func Test_TableModelField(t *testing.T) {
 		},
 		Tags: nil,
 	})
+
+	require.Equal(t, DefaultTableModelField(metadata.Column{
+		Name:       "period",
+		IsNullable: true,
+		DataType: metadata.DataType{
+			Name: "tstzrange",
+			Kind: "base",
+		},
+	}), TableModelField{
+		Name: "Period",
+		Type: Type{
+			ImportPath: "github.com/go-jet/jet/v2/postgres/pgrange",
+			Name:       "*pgrange.TsTzRange",
+		},
+		Tags: nil,
+	})
 }
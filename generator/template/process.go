@@ -62,6 +62,63 @@ func processSQLBuilder(dirPath string, dialect jet.Dialect, schemaMetaData metad
 	processTableSQLBuilder("table", sqlBuilderPath, dialect, schemaMetaData, schemaMetaData.TablesMetaData, sqlBuilderTemplate)
 	processTableSQLBuilder("view", sqlBuilderPath, dialect, schemaMetaData, schemaMetaData.ViewsMetaData, sqlBuilderTemplate)
 	processEnumSQLBuilder(sqlBuilderPath, dialect, schemaMetaData.EnumsMetaData, sqlBuilderTemplate)
+	processSequenceSQLBuilder(sqlBuilderPath, dialect, schemaMetaData, sqlBuilderTemplate)
+}
+
+func processSequenceSQLBuilder(dirPath string, dialect jet.Dialect, schemaMetaData metadata.Schema, sqlBuilder SQLBuilder) {
+	if len(schemaMetaData.SequencesMetaData) == 0 {
+		return
+	}
+
+	fmt.Printf("Generating sequence sql builder files\n")
+
+	var instanceNames []string
+	var useSchemaPath string
+
+	for _, sequenceMetaData := range schemaMetaData.SequencesMetaData {
+		sequenceTemplate := sqlBuilder.Sequence(sequenceMetaData)
+
+		if sequenceTemplate.Skip {
+			continue
+		}
+
+		sequenceSQLBuilderPath := path.Join(dirPath, sequenceTemplate.Path)
+
+		err := utils.EnsureDirPath(sequenceSQLBuilderPath)
+		throw.OnError(err)
+
+		text, err := generateTemplate(
+			autoGenWarningTemplate+sequenceSQLBuilderTemplate,
+			sequenceMetaData,
+			template.FuncMap{
+				"package": func() string {
+					return sequenceTemplate.PackageName()
+				},
+				"dialect": func() jet.Dialect {
+					return dialect
+				},
+				"schemaName": func() string {
+					return schemaMetaData.Name
+				},
+				"sequenceTemplate": func() SequenceSQLBuilder {
+					return sequenceTemplate
+				},
+			})
+		throw.OnError(err)
+
+		err = utils.SaveGoFile(sequenceSQLBuilderPath, sequenceTemplate.FileName, text)
+		throw.OnError(err)
+
+		if len(instanceNames) == 0 {
+			useSchemaPath = sequenceSQLBuilderPath
+		}
+
+		instanceNames = append(instanceNames, sequenceTemplate.InstanceName)
+	}
+
+	if len(instanceNames) > 0 {
+		generateUseSchemaFunc(useSchemaPath, "sequence", instanceNames)
+	}
 }
 
 func processEnumSQLBuilder(dirPath string, dialect jet.Dialect, enumsMetaData []metadata.Enum, sqlBuilder SQLBuilder) {
@@ -119,7 +176,8 @@ func processTableSQLBuilder(fileTypes, dirPath string,
 
 	fmt.Printf("Generating %s sql builder files\n", fileTypes)
 
-	var generatedBuilders []TableSQLBuilder
+	var instanceNames []string
+	var useSchemaPath string
 
 	for _, tableMetaData := range tablesMetaData {
 
@@ -161,7 +219,7 @@ func processTableSQLBuilder(fileTypes, dirPath string,
 					return string(strings.ToLower(structName)[0]) + structName[1:]
 				},
 				"columnField": func(columnMetaData metadata.Column) TableSQLBuilderColumn {
-					return tableSQLBuilder.Column(columnMetaData)
+					return dialectColumnType(dialect, columnMetaData, tableSQLBuilder.Column(columnMetaData))
 				},
 				"toUpper": strings.ToUpper,
 				"insertedRowAlias": func() string {
@@ -173,33 +231,47 @@ func processTableSQLBuilder(fileTypes, dirPath string,
 		err = utils.SaveGoFile(tableSQLBuilderPath, tableSQLBuilder.FileName, text)
 		throw.OnError(err)
 
-		generatedBuilders = append(generatedBuilders, tableSQLBuilder)
+		if len(instanceNames) == 0 {
+			useSchemaPath = tableSQLBuilderPath
+		}
+
+		instanceNames = append(instanceNames, tableSQLBuilder.InstanceName)
 	}
 
-	if len(generatedBuilders) > 0 {
-		generateUseSchemaFunc(dirPath, fileTypes, generatedBuilders)
+	if len(instanceNames) > 0 {
+		generateUseSchemaFunc(useSchemaPath, fileTypes, instanceNames)
 	}
 }
 
-func generateUseSchemaFunc(dirPath, fileTypes string, builders []TableSQLBuilder) {
+func generateUseSchemaFunc(basePath, fileTypes string, instanceNames []string) {
 
 	text, err := generateTemplate(
 		autoGenWarningTemplate+tableSqlBuilderSetSchemaTemplate,
-		builders,
+		instanceNames,
 		template.FuncMap{
-			"package": func() string { return builders[0].PackageName() },
+			"package": func() string { return path.Base(basePath) },
 			"type":    func() string { return fileTypes },
 		},
 	)
 	throw.OnError(err)
 
-	basePath := path.Join(dirPath, builders[0].Path)
 	fileName := fileTypes + "_use_schema"
 
 	err = utils.SaveGoFile(basePath, fileName, text)
 	throw.OnError(err)
 }
 
+// dialectColumnType replaces default column type with dialect specific column type. MySQL json columns
+// are generated as json columns, while PostgreSQL json columns remain string columns.
+func dialectColumnType(dialect jet.Dialect, columnMetaData metadata.Column, column TableSQLBuilderColumn) TableSQLBuilderColumn {
+	if dialect.Name() == "MySQL" && column.Type == "String" &&
+		columnMetaData.DataType.Kind == metadata.BaseType && strings.ToLower(columnMetaData.DataType.Name) == "json" {
+		column.Type = "Json"
+	}
+
+	return column
+}
+
 func insertedRowAlias(dialect jet.Dialect) string {
 	if dialect.Name() == "MySQL" {
 		return "new"
@@ -16,6 +16,8 @@ type SQLBuilder struct {
 	Table func(table metadata.Table) TableSQLBuilder
 	View  func(view metadata.Table) TableSQLBuilder
 	Enum  func(enum metadata.Enum) EnumSQLBuilder
+
+	Sequence func(sequence metadata.Sequence) SequenceSQLBuilder
 }
 
 // DefaultSQLBuilder returns default SQLBuilder implementation
@@ -25,6 +27,8 @@ func DefaultSQLBuilder() SQLBuilder {
 		Table: DefaultTableSQLBuilder,
 		View:  DefaultViewSQLBuilder,
 		Enum:  DefaultEnumSQLBuilder,
+
+		Sequence: DefaultSequenceSQLBuilder,
 	}
 }
 
@@ -52,6 +56,12 @@ func (sb SQLBuilder) UseEnum(enumFunc func(enum metadata.Enum) EnumSQLBuilder) S
 	return sb
 }
 
+// UseSequence returns new SQLBuilder with new SequenceSQLBuilder template function set
+func (sb SQLBuilder) UseSequence(sequenceFunc func(sequence metadata.Sequence) SequenceSQLBuilder) SQLBuilder {
+	sb.Sequence = sequenceFunc
+	return sb
+}
+
 // TableSQLBuilder is template for generating table SQLBuilder files
 type TableSQLBuilder struct {
 	Skip         bool
@@ -158,8 +168,36 @@ func getSqlBuilderColumnType(columnMetaData metadata.Column) string {
 		return "Timez"
 	case "interval":
 		return "Interval"
+	case "tsvector":
+		return "TsVector"
+	case "tsquery":
+		return "TsQuery"
+	case "int4range":
+		return "Int4Range"
+	case "int8range":
+		return "Int8Range"
+	case "numrange":
+		return "NumRange"
+	case "tsrange":
+		return "TsRange"
+	case "tstzrange":
+		return "TsTzRange"
+	case "daterange":
+		return "DateRange"
+	case "int4multirange":
+		return "Int4MultiRange"
+	case "int8multirange":
+		return "Int8MultiRange"
+	case "nummultirange":
+		return "NumMultiRange"
+	case "tsmultirange":
+		return "TsMultiRange"
+	case "tstzmultirange":
+		return "TsTzMultiRange"
+	case "datemultirange":
+		return "DateMultiRange"
 	case "user-defined", "enum", "text", "character", "character varying", "bytea", "uuid",
-		"tsvector", "bit", "bit varying", "money", "json", "jsonb", "xml", "point", "line", "ARRAY",
+		"bit", "bit varying", "money", "json", "jsonb", "xml", "point", "line", "ARRAY",
 		"char", "varchar", "nvarchar", "binary", "varbinary",
 		"tinyblob", "blob", "mediumblob", "longblob", "tinytext", "mediumtext", "longtext": // MySQL
 		return "String"
@@ -216,6 +254,46 @@ func (e EnumSQLBuilder) UseInstanceName(name string) EnumSQLBuilder {
 	return e
 }
 
+// SequenceSQLBuilder is template for generating sequence SQLBuilder files
+type SequenceSQLBuilder struct {
+	Skip         bool
+	Path         string
+	FileName     string
+	InstanceName string
+}
+
+// DefaultSequenceSQLBuilder returns default implementation of SequenceSQLBuilder
+func DefaultSequenceSQLBuilder(sequenceMetaData metadata.Sequence) SequenceSQLBuilder {
+	return SequenceSQLBuilder{
+		Path:         "/sequence",
+		FileName:     utils.ToGoFileName(sequenceMetaData.Name),
+		InstanceName: utils.ToGoIdentifier(sequenceMetaData.Name),
+	}
+}
+
+// PackageName returns sequence sql builder package name
+func (s SequenceSQLBuilder) PackageName() string {
+	return path.Base(s.Path)
+}
+
+// UsePath returns new SequenceSQLBuilder with new path set
+func (s SequenceSQLBuilder) UsePath(path string) SequenceSQLBuilder {
+	s.Path = path
+	return s
+}
+
+// UseFileName returns new SequenceSQLBuilder with new file name set
+func (s SequenceSQLBuilder) UseFileName(name string) SequenceSQLBuilder {
+	s.FileName = name
+	return s
+}
+
+// UseInstanceName returns new SequenceSQLBuilder with instance name set
+func (s SequenceSQLBuilder) UseInstanceName(name string) SequenceSQLBuilder {
+	s.InstanceName = name
+	return s
+}
+
 func defaultEnumValueName(enumName, enumValue string) string {
 	enumValueName := utils.ToGoIdentifier(enumValue)
 	if !unicode.IsLetter([]rune(enumValueName)[0]) {
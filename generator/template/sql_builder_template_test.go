@@ -1,6 +1,9 @@
 package template
 
 import (
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/postgres"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -9,3 +12,21 @@ func TestToGoEnumValueIdentifier(t *testing.T) {
 	require.Equal(t, defaultEnumValueName("enum_name", "enum_value"), "EnumValue")
 	require.Equal(t, defaultEnumValueName("NumEnum", "100"), "NumEnum100")
 }
+
+func TestDefaultSequenceSQLBuilder(t *testing.T) {
+	sequenceSQLBuilder := DefaultSequenceSQLBuilder(metadata.Sequence{Name: "film_film_id_seq"})
+
+	require.Equal(t, "sequence", sequenceSQLBuilder.PackageName())
+	require.Equal(t, "film_film_id_seq", sequenceSQLBuilder.FileName)
+	require.Equal(t, "FilmFilmIDSeq", sequenceSQLBuilder.InstanceName)
+}
+
+func TestDialectColumnType(t *testing.T) {
+	jsonColumn := metadata.Column{
+		Name:     "info",
+		DataType: metadata.DataType{Name: "json", Kind: metadata.BaseType},
+	}
+
+	require.Equal(t, "Json", dialectColumnType(mysql.Dialect, jsonColumn, DefaultTableSQLBuilderColumn(jsonColumn)).Type)
+	require.Equal(t, "String", dialectColumnType(postgres.Dialect, jsonColumn, DefaultTableSQLBuilderColumn(jsonColumn)).Type)
+}
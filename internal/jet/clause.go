@@ -236,20 +236,63 @@ func (o *ClauseOffset) Serialize(statementType StatementType, out *SQLBuilder, o
 	}
 }
 
-// ClauseFor struct
-type ClauseFor struct {
-	Lock RowLock
+// ClauseFetch struct
+type ClauseFetch struct {
+	Count    int64
+	WithTies bool
+	// Limit and OrderBy clauses of the statement, if set FETCH FIRST is validated not to be combined with LIMIT,
+	// and WITH TIES to be used with ORDER BY
+	Limit   *ClauseLimit
+	OrderBy *ClauseOrderBy
 }
 
 // Serialize serializes clause into SQLBuilder
-func (f *ClauseFor) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
-	if f.Lock == nil {
+func (f *ClauseFetch) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if f.Count < 0 {
 		return
 	}
 
+	if f.Limit != nil && f.Limit.Count >= 0 {
+		panic("jet: FETCH FIRST and LIMIT clauses can't be used in the same statement")
+	}
+
+	if f.WithTies && f.OrderBy != nil && len(f.OrderBy.List) == 0 {
+		panic("jet: FETCH FIRST WITH TIES requires ORDER BY clause")
+	}
+
 	out.NewLine()
-	out.WriteString("FOR")
-	f.Lock.serialize(statementType, out, FallTrough(options)...)
+	out.WriteString("FETCH FIRST")
+	out.insertParametrizedArgument(f.Count)
+
+	if f.WithTies {
+		out.WriteString("ROWS WITH TIES")
+	} else {
+		out.WriteString("ROWS ONLY")
+	}
+}
+
+// ClauseFor struct
+type ClauseFor struct {
+	Locks []RowLock
+	// From clause of the statement, if set row lock OF tables are validated to appear in FROM clause
+	From *ClauseFrom
+}
+
+// Serialize serializes clause into SQLBuilder
+func (f *ClauseFor) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
+	for _, lock := range f.Locks {
+		if lock == nil {
+			continue
+		}
+
+		if f.From != nil {
+			validateRowLockTables(lock, f.From.Tables)
+		}
+
+		out.NewLine()
+		out.WriteString("FOR")
+		lock.serialize(statementType, out, FallTrough(options)...)
+	}
 }
 
 // ClauseSetStmtOperator struct
@@ -358,6 +401,11 @@ func (s *SetClause) Serialize(statementType StatementType, out *SQLBuilder, opti
 			panic("jet: nil column in columns list for SET clause")
 		}
 
+		if column.TableName() != "" && contains(options, QualifiedColumnNames) {
+			out.WriteIdentifier(column.TableName())
+			out.WriteString(".")
+		}
+
 		out.WriteIdentifier(column.Name())
 
 		out.WriteString(" = ")
@@ -374,6 +422,8 @@ type ClauseInsert struct {
 
 	// MySQL only
 	OptimizerHints optimizerHints
+	Ignore         bool // INSERT IGNORE
+	Replace        bool // REPLACE statement
 }
 
 // GetColumns gets list of columns for insert
@@ -392,8 +442,15 @@ func (i *ClauseInsert) Serialize(statementType StatementType, out *SQLBuilder, o
 	}
 
 	out.NewLine()
-	out.WriteString("INSERT")
+	if i.Replace {
+		out.WriteString("REPLACE")
+	} else {
+		out.WriteString("INSERT")
+	}
 	i.OptimizerHints.Serialize(statementType, out, options...)
+	if i.Ignore {
+		out.WriteString("IGNORE")
+	}
 	out.WriteString("INTO")
 
 	i.Table.serialize(statementType, out)
@@ -486,6 +543,7 @@ type ClauseDelete struct {
 
 	// MySQL only
 	OptimizerHints optimizerHints
+	Targets        []Table // tables to delete rows from, if Table is join table
 }
 
 // Serialize serializes clause into SQLBuilder
@@ -493,6 +551,32 @@ func (d *ClauseDelete) Serialize(statementType StatementType, out *SQLBuilder, o
 	out.NewLine()
 	out.WriteString("DELETE")
 	d.OptimizerHints.Serialize(statementType, out, options...)
+
+	if len(d.Targets) > 0 {
+		sourceNames := tableSourceNames(d.Table)
+
+		for i, target := range d.Targets {
+			if utils.IsNil(target) {
+				panic("jet: nil table in the list of tables to delete from")
+			}
+
+			if !utils.StringSliceContains(sourceNames, tableReferenceName(target)) {
+				panic("jet: table to delete from '" + tableReferenceName(target) + "' does not appear in FROM clause")
+			}
+
+			if i > 0 {
+				out.WriteString(", ")
+			}
+
+			if target.Alias() == "" && target.SchemaName() != "" {
+				out.WriteIdentifier(target.SchemaName())
+				out.WriteString(".")
+			}
+
+			out.WriteIdentifier(tableReferenceName(target))
+		}
+	}
+
 	out.WriteString("FROM")
 	d.Table.serialize(statementType, out, FallTrough(options)...)
 }
@@ -102,6 +102,10 @@ func (c ColumnExpressionImpl) serializeForProjection(statement StatementType, ou
 
 func (c ColumnExpressionImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
 
+	if out.tableReferences != nil {
+		c.addTableReference(out.tableReferences)
+	}
+
 	if c.subQuery != nil {
 		out.WriteIdentifier(c.subQuery.Alias())
 		out.WriteByte('.')
@@ -115,3 +119,11 @@ func (c ColumnExpressionImpl) serialize(statement StatementType, out *SQLBuilder
 		out.WriteIdentifier(c.name)
 	}
 }
+
+func (c ColumnExpressionImpl) addTableReference(tableReferences map[string]bool) {
+	if c.subQuery != nil {
+		tableReferences[c.subQuery.Alias()] = true
+	} else if c.tableName != "" {
+		tableReferences[c.tableName] = true
+	}
+}
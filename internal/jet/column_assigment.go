@@ -14,7 +14,55 @@ type columnAssigmentImpl struct {
 func (a columnAssigmentImpl) isColumnAssigment() {}
 
 func (a columnAssigmentImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
-	a.column.serialize(statement, out, ShortName.WithFallTrough(options)...)
+	if contains(options, QualifiedColumnNames) {
+		a.column.serialize(statement, out, FallTrough(options)...)
+	} else {
+		a.column.serialize(statement, out, ShortName.WithFallTrough(options)...)
+	}
 	out.WriteString("=")
 	a.expression.serialize(statement, out, FallTrough(options)...)
 }
+
+// NewColumnAssigment creates column assigment of expression to column.
+func NewColumnAssigment(column ColumnSerializer, expression Expression) ColumnAssigment {
+	return columnAssigmentImpl{
+		column:     column,
+		expression: expression,
+	}
+}
+
+// NewColumnAssigments creates column assigment for each column not contained in the list of excluded columns.
+// Each column is assigned the expression created by newValue function, for instance the value of the same column
+// of the row proposed for insertion.
+func NewColumnAssigments(columns []Column, excludedColumns []Column, newValue func(column Column) Expression) []ColumnAssigment {
+	var ret []ColumnAssigment
+
+	for _, column := range columns {
+		if containsColumn(excludedColumns, column) {
+			continue
+		}
+
+		columnSerializer, ok := column.(ColumnSerializer)
+
+		if !ok {
+			panic("jet: column " + column.Name() + " can not be assigned")
+		}
+
+		ret = append(ret, columnAssigmentImpl{
+			column:     columnSerializer,
+			expression: newValue(column),
+		})
+	}
+
+	if len(ret) == 0 {
+		panic("jet: no columns selected")
+	}
+
+	return ret
+}
+
+// NewTableColumn creates new column expression referencing column with the same name from table tableName
+func NewTableColumn(tableName string, column Column) Expression {
+	newColumn := NewColumnImpl(column.Name(), tableName, nil)
+	return &newColumn
+}
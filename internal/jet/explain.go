@@ -0,0 +1,147 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// PlanNode is a node of the statement query plan tree
+type PlanNode struct {
+	// NodeType is a kind of the plan node, for instance 'Seq Scan' or 'Index Scan' on PostgreSQL, table access
+	// type ('ALL', 'ref', 'range', ...) or operation on MySQL, and 'SCAN' or 'SEARCH' on SQLite.
+	NodeType string
+	// Relation is the name of the table node reads rows from, if any.
+	Relation string
+	// Index is the name of the index node uses to access the table rows, if any.
+	Index string
+	// Detail is database specific node description, for instance node condition.
+	Detail string
+
+	// EstimatedRows is planner estimate of the number of rows node returns.
+	EstimatedRows float64
+	// ActualRows is the number of rows node returned. It is set only if the statement has been executed (ANALYZE).
+	ActualRows *float64
+	// StartupCost is planner estimated cost before the first row can be returned.
+	StartupCost float64
+	// TotalCost is planner estimated cost to return all the rows.
+	TotalCost float64
+
+	Children []PlanNode
+}
+
+// QueryPlan is statement query plan, parsed from EXPLAIN statement output
+type QueryPlan struct {
+	Nodes []PlanNode
+}
+
+// Find returns all the plan nodes, in depth first order, satisfying condition
+func (q QueryPlan) Find(condition func(node PlanNode) bool) []PlanNode {
+	var ret []PlanNode
+
+	var find func(nodes []PlanNode)
+
+	find = func(nodes []PlanNode) {
+		for _, node := range nodes {
+			if condition(node) {
+				ret = append(ret, node)
+			}
+
+			find(node.Children)
+		}
+	}
+
+	find(q.Nodes)
+
+	return ret
+}
+
+// UsesIndex returns true if any of the plan nodes uses index with the name index
+func (q QueryPlan) UsesIndex(index string) bool {
+	return len(q.Find(func(node PlanNode) bool {
+		return node.Index == index
+	})) > 0
+}
+
+// PlanParser parses EXPLAIN statement result rows into the list of query plan root nodes
+type PlanParser func(rows *sql.Rows) ([]PlanNode, error)
+
+// ExplainStatement is a statement showing the execution plan of explained statement
+type ExplainStatement interface {
+	Statement
+
+	// QueryPlan executes EXPLAIN statement over database connection/transaction db and parses the statement output
+	// into query plan tree.
+	QueryPlan(ctx context.Context, db qrm.Queryable) (*QueryPlan, error)
+}
+
+type explainStatementImpl struct {
+	serializerStatementInterfaceImpl
+
+	explain   string
+	statement Statement
+	parsePlan PlanParser
+}
+
+// NewExplainStatement creates new statement that prepends statement with explain clause, and parses the output
+// with parsePlan.
+func NewExplainStatement(dialect Dialect, explain string, statement Statement, parsePlan PlanParser) ExplainStatement {
+	if statement == nil {
+		panic("jet: explained statement is nil")
+	}
+
+	newExplain := &explainStatementImpl{
+		serializerStatementInterfaceImpl: serializerStatementInterfaceImpl{
+			dialect:       dialect,
+			statementType: "",
+		},
+		explain:   explain,
+		statement: statement,
+		parsePlan: parsePlan,
+	}
+
+	newExplain.parent = newExplain
+
+	return newExplain
+}
+
+func (e *explainStatementImpl) projections() ProjectionList {
+	return nil
+}
+
+func (e *explainStatementImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	serializer, ok := e.statement.(Serializer)
+
+	if !ok {
+		panic("jet: unsupported statement type in explain statement")
+	}
+
+	out.NewLine()
+	out.WriteString(e.explain)
+
+	serializer.serialize(statement, out, NoWrap)
+}
+
+func (e *explainStatementImpl) QueryPlan(ctx context.Context, db qrm.Queryable) (*QueryPlan, error) {
+	rows, err := e.Rows(ctx, db)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	nodes, err := e.parsePlan(rows.Rows)
+
+	if err != nil {
+		return nil, fmt.Errorf("jet: failed to parse query plan: %w", err)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("jet: %w", err)
+	}
+
+	return &QueryPlan{Nodes: nodes}, nil
+}
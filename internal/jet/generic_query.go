@@ -0,0 +1,80 @@
+package jet
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// QueryAll executes statement over database connection/transaction db and returns all the mapped rows as a slice of T.
+// T can be a struct or pointer to a struct (rows are grouped into T objects using primary key fields), a simple type
+// like int64 or string (first column of each row is returned), or map[string]interface{} (one map for each row).
+func QueryAll[T any](ctx context.Context, statement Statement, db qrm.Queryable) ([]T, error) {
+	var dest []T
+
+	err := statement.QueryContext(ctx, db, &dest)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return dest, nil
+}
+
+// QueryOne executes statement over database connection/transaction db and returns the first mapped T object.
+// If query result set is empty, QueryOne returns qrm.ErrNoRows.
+func QueryOne[T any](ctx context.Context, statement Statement, db qrm.Queryable) (T, error) {
+	var zero T
+
+	dest, err := QueryAll[T](ctx, statement, db)
+
+	if err != nil {
+		return zero, err
+	}
+
+	if len(dest) == 0 {
+		return zero, qrm.ErrNoRows
+	}
+
+	return dest[0], nil
+}
+
+// QueryScalar executes statement over database connection/transaction db and returns the value of the single column
+// from the first row of the query result set, converted to T by database/sql. To receive NULL values T has to be
+// a pointer, or a type implementing sql.Scanner. If query result set is empty, QueryScalar returns qrm.ErrNoRows.
+func QueryScalar[T any](ctx context.Context, statement Statement, db qrm.Queryable) (T, error) {
+	var dest T
+
+	rows, err := statement.Rows(ctx, db)
+
+	if err != nil {
+		return dest, err
+	}
+
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+
+	if err != nil {
+		return dest, err
+	}
+
+	if len(columns) != 1 {
+		return dest, fmt.Errorf("jet: scalar query has to return single column, got %d", len(columns))
+	}
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return dest, err
+		}
+
+		return dest, qrm.ErrNoRows
+	}
+
+	if err := rows.Rows.Scan(&dest); err != nil {
+		return dest, fmt.Errorf("jet: %w", err)
+	}
+
+	return dest, rows.Close()
+}
@@ -0,0 +1,137 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// NewBatchStatementFunc creates new statement for a batch of rows
+type NewBatchStatementFunc func(rows [][]Serializer) Statement
+
+// ExecInBatches splits rows into batches, so that statement created for each batch does not exceed maxArguments
+// number of parametrized arguments. Batch statements are then executed in sequence over db connection/transaction.
+// Returned result contains the total number of rows affected by all the batches and the last insert id of
+// the last batch executed. If any of the batches fail, execution stops and error is returned. Batches executed
+// before the failing batch are rolled back only if db is a transaction rolled back by the caller.
+// If there are no rows, no statement is executed and returned result has zero rows affected.
+func ExecInBatches(ctx context.Context, db qrm.Executable, dialect Dialect, maxArguments int,
+	rows [][]Serializer, newStatement NewBatchStatementFunc) (sql.Result, error) {
+
+	batches, err := splitRowsIntoBatches(dialect, maxArguments, rows, newStatement)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var ret batchResult
+
+	for _, batch := range batches {
+		res, err := newStatement(batch).ExecContext(ctx, db)
+
+		if err != nil {
+			return nil, err
+		}
+
+		rowsAffected, err := res.RowsAffected()
+
+		if err != nil {
+			return nil, err
+		}
+
+		ret.rowsAffected += rowsAffected
+		ret.lastInsertID, ret.lastInsertIDErr = res.LastInsertId()
+	}
+
+	return ret, nil
+}
+
+// QueryInBatches splits rows into batches, so that statement created for each batch does not exceed maxArguments
+// number of parametrized arguments. Batch statements are then executed in sequence over db connection/transaction,
+// and returned rows of each batch are appended to the destination. Destination has to be a pointer to a slice.
+// As with ExecInBatches, batches are not executed atomically unless db is a transaction, and no statement is
+// executed if there are no rows.
+func QueryInBatches(ctx context.Context, db qrm.Queryable, dialect Dialect, maxArguments int,
+	rows [][]Serializer, newStatement NewBatchStatementFunc, destination interface{}) error {
+
+	destinationValue := reflect.ValueOf(destination)
+
+	if destinationValue.Kind() != reflect.Ptr || destinationValue.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("jet: batch query destination has to be a pointer to a slice, got %T", destination)
+	}
+
+	batches, err := splitRowsIntoBatches(dialect, maxArguments, rows, newStatement)
+
+	if err != nil {
+		return err
+	}
+
+	for _, batch := range batches {
+		err := newStatement(batch).QueryContext(ctx, db, destination)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func splitRowsIntoBatches(dialect Dialect, maxArguments int, rows [][]Serializer, newStatement NewBatchStatementFunc) ([][][]Serializer, error) {
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	_, statementArgs := newStatement(nil).Sql()
+
+	var batches [][][]Serializer
+	var batch [][]Serializer
+	batchArguments := len(statementArgs)
+
+	for i, row := range rows {
+		rowArguments, err := rowArgumentsCount(dialect, row)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(statementArgs)+rowArguments > maxArguments {
+			return nil, fmt.Errorf("jet: row %d requires %d arguments, exceeding maximum number of arguments per statement (%d)",
+				i, len(statementArgs)+rowArguments, maxArguments)
+		}
+
+		if batchArguments+rowArguments > maxArguments {
+			batches = append(batches, batch)
+			batch = nil
+			batchArguments = len(statementArgs)
+		}
+
+		batch = append(batch, row)
+		batchArguments += rowArguments
+	}
+
+	return append(batches, batch), nil
+}
+
+func rowArgumentsCount(dialect Dialect, row []Serializer) (int, error) {
+	out := SQLBuilder{Dialect: dialect}
+	SerializeClauseList(InsertStatementType, row, &out)
+	return len(out.Args), out.err
+}
+
+type batchResult struct {
+	rowsAffected    int64
+	lastInsertID    int64
+	lastInsertIDErr error
+}
+
+func (b batchResult) LastInsertId() (int64, error) {
+	return b.lastInsertID, b.lastInsertIDErr
+}
+
+func (b batchResult) RowsAffected() (int64, error) {
+	return b.rowsAffected, nil
+}
@@ -0,0 +1,216 @@
+package jet
+
+// JsonTableColumn is column definition of JSON_TABLE table function
+type JsonTableColumn interface {
+	serializeJsonTableColumn(statement StatementType, out *SQLBuilder)
+	tableColumns() []ColumnExpression
+}
+
+type jsonTableOrdinalityColumn struct {
+	column ColumnExpression
+}
+
+// NewJsonTableOrdinalityColumn creates JSON_TABLE column enumerating rows (column FOR ORDINALITY)
+func NewJsonTableOrdinalityColumn(column ColumnExpression) JsonTableColumn {
+	return jsonTableOrdinalityColumn{column: column}
+}
+
+func (j jsonTableOrdinalityColumn) serializeJsonTableColumn(statement StatementType, out *SQLBuilder) {
+	out.WriteIdentifier(j.column.Name())
+	out.WriteString("FOR ORDINALITY")
+}
+
+func (j jsonTableOrdinalityColumn) tableColumns() []ColumnExpression {
+	return []ColumnExpression{j.column}
+}
+
+// JsonTablePathColumn is JSON_TABLE column with value extracted from the JSON path
+type JsonTablePathColumn interface {
+	JsonTableColumn
+
+	// DEFAULT_ON_EMPTY sets JSON value used when the path does not match any value
+	DEFAULT_ON_EMPTY(jsonValue string) JsonTablePathColumn
+	// ERROR_ON_EMPTY raises an error when the path does not match any value
+	ERROR_ON_EMPTY() JsonTablePathColumn
+	// DEFAULT_ON_ERROR sets JSON value used when the value can not be converted to the column type
+	DEFAULT_ON_ERROR(jsonValue string) JsonTablePathColumn
+	// ERROR_ON_ERROR raises an error when the value can not be converted to the column type
+	ERROR_ON_ERROR() JsonTablePathColumn
+}
+
+type jsonTableResponse struct {
+	errorResponse bool
+	defaultValue  *string
+}
+
+func (j jsonTableResponse) serialize(out *SQLBuilder, event string) {
+	if j.errorResponse {
+		out.WriteString("ERROR ON " + event)
+	} else if j.defaultValue != nil {
+		out.WriteString("DEFAULT")
+		out.insertConstantArgument(*j.defaultValue)
+		out.WriteString("ON " + event)
+	}
+}
+
+type jsonTablePathColumn struct {
+	column  ColumnExpression
+	sqlType string
+	exists  bool
+	path    string
+
+	onEmpty jsonTableResponse
+	onError jsonTableResponse
+}
+
+// NewJsonTablePathColumn creates JSON_TABLE column of sqlType type, with value extracted from the path
+// (column type PATH path). If exists is true, column value is 1 if the path matches any value, and 0 otherwise
+// (column type EXISTS PATH path).
+func NewJsonTablePathColumn(column ColumnExpression, sqlType string, path string, exists bool) JsonTablePathColumn {
+	return jsonTablePathColumn{
+		column:  column,
+		sqlType: sqlType,
+		exists:  exists,
+		path:    path,
+	}
+}
+
+func (j jsonTablePathColumn) DEFAULT_ON_EMPTY(jsonValue string) JsonTablePathColumn {
+	j.onEmpty = jsonTableResponse{defaultValue: &jsonValue}
+	return j
+}
+
+func (j jsonTablePathColumn) ERROR_ON_EMPTY() JsonTablePathColumn {
+	j.onEmpty = jsonTableResponse{errorResponse: true}
+	return j
+}
+
+func (j jsonTablePathColumn) DEFAULT_ON_ERROR(jsonValue string) JsonTablePathColumn {
+	j.onError = jsonTableResponse{defaultValue: &jsonValue}
+	return j
+}
+
+func (j jsonTablePathColumn) ERROR_ON_ERROR() JsonTablePathColumn {
+	j.onError = jsonTableResponse{errorResponse: true}
+	return j
+}
+
+func (j jsonTablePathColumn) serializeJsonTableColumn(statement StatementType, out *SQLBuilder) {
+	if j.sqlType == "" {
+		panic("jet: JSON_TABLE column '" + j.column.Name() + "' type is empty")
+	}
+
+	out.WriteIdentifier(j.column.Name())
+	out.WriteString(j.sqlType)
+
+	if j.exists {
+		out.WriteString("EXISTS")
+	}
+
+	out.WriteString("PATH")
+	out.insertConstantArgument(j.path) // JSON_TABLE paths have to be string literals
+
+	j.onEmpty.serialize(out, "EMPTY")
+	j.onError.serialize(out, "ERROR")
+}
+
+func (j jsonTablePathColumn) tableColumns() []ColumnExpression {
+	return []ColumnExpression{j.column}
+}
+
+type jsonTableNestedColumns struct {
+	path    string
+	columns []JsonTableColumn
+}
+
+// NewJsonTableNestedColumns creates JSON_TABLE nested columns, producing a row for each value matched by the path
+// (NESTED PATH path COLUMNS (...))
+func NewJsonTableNestedColumns(path string, columns []JsonTableColumn) JsonTableColumn {
+	if len(columns) == 0 {
+		panic("jet: JSON_TABLE NESTED PATH requires at least one column")
+	}
+
+	return jsonTableNestedColumns{
+		path:    path,
+		columns: columns,
+	}
+}
+
+func (j jsonTableNestedColumns) serializeJsonTableColumn(statement StatementType, out *SQLBuilder) {
+	out.WriteString("NESTED PATH")
+	out.insertConstantArgument(j.path)
+	serializeJsonTableColumns(statement, out, j.columns)
+}
+
+func (j jsonTableNestedColumns) tableColumns() []ColumnExpression {
+	var ret []ColumnExpression
+
+	for _, column := range j.columns {
+		ret = append(ret, column.tableColumns()...)
+	}
+
+	return ret
+}
+
+func serializeJsonTableColumns(statement StatementType, out *SQLBuilder, columns []JsonTableColumn) {
+	out.WriteString("COLUMNS (")
+	out.IncreaseIdent()
+
+	for i, column := range columns {
+		if i > 0 {
+			out.WriteString(",")
+		}
+
+		out.NewLine()
+		column.serializeJsonTableColumn(statement, out)
+	}
+
+	out.DecreaseIdent()
+	out.NewLine()
+	out.WriteString(")")
+}
+
+type jsonTableStatement struct {
+	json    Expression
+	path    string
+	columns jsonTableNestedColumns
+}
+
+func (j *jsonTableStatement) projections() ProjectionList {
+	var ret ProjectionList
+
+	for _, column := range j.columns.tableColumns() {
+		ret = append(ret, column)
+	}
+
+	return ret
+}
+
+func (j *jsonTableStatement) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if j.json == nil {
+		panic("jet: JSON_TABLE json expression is nil")
+	}
+
+	out.WriteString("JSON_TABLE(")
+	j.json.serialize(statement, out, NoWrap)
+	out.WriteString(", ")
+	out.insertConstantArgument(j.path)
+	serializeJsonTableColumns(statement, out, j.columns.columns)
+	out.WriteByte(')')
+}
+
+// NewJsonTable creates new table source from JSON document, with a row for each value matched by the path,
+// and a column for each of JSON_TABLE columns.
+func NewJsonTable(json Expression, path string, columns []JsonTableColumn, alias string) SelectTable {
+	if len(columns) == 0 {
+		panic("jet: JSON_TABLE requires at least one column")
+	}
+
+	statement := &jsonTableStatement{
+		json:    json,
+		path:    path,
+		columns: jsonTableNestedColumns{columns: columns},
+	}
+
+	return NewSelectTable(statement, alias)
+}
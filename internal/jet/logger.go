@@ -2,6 +2,7 @@ package jet
 
 import (
 	"context"
+	"reflect"
 	"runtime"
 	"strings"
 	"time"
@@ -57,6 +58,28 @@ func callQueryLoggerFunc(ctx context.Context, info QueryInfo) {
 	}
 }
 
+// internalPackagePath is import path of the jet internal packages, for instance github.com/go-jet/jet/v2/internal
+var internalPackagePath = strings.TrimSuffix(reflect.TypeOf(QueryInfo{}).PkgPath(), "/jet")
+
+var executorPackagePaths = []string{internalPackagePath}
+
+// AddExecutorPackage registers import path of the package executing statements on behalf of the caller, so that
+// QueryInfo.Caller skips package functions when looking for the statement caller. It should be called from
+// package init function.
+func AddExecutorPackage(packagePath string) {
+	executorPackagePaths = append(executorPackagePaths, packagePath)
+}
+
+func isExecutorFunc(funcName string) bool {
+	for _, packagePath := range executorPackagePaths {
+		if strings.HasPrefix(funcName, packagePath+".") || strings.HasPrefix(funcName, packagePath+"/") {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Caller returns information about statement caller
 func (q QueryInfo) Caller() (file string, line int, function string) {
 	skip := 4
@@ -71,7 +94,7 @@ func (q QueryInfo) Caller() (file string, line int, function string) {
 		}
 
 		funcDetails := runtime.FuncForPC(pc)
-		if !strings.Contains(funcDetails.Name(), "github.com/go-jet/jet/v2/internal") {
+		if !isExecutorFunc(funcDetails.Name()) {
 			function = funcDetails.Name()
 			return
 		}
@@ -0,0 +1,23 @@
+package jet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsExecutorFunc(t *testing.T) {
+	require.Equal(t, "github.com/go-jet/jet/v2/internal", internalPackagePath)
+
+	require.True(t, isExecutorFunc("github.com/go-jet/jet/v2/internal/jet.(*serializerStatementInterfaceImpl).Query"))
+	require.True(t, isExecutorFunc("github.com/go-jet/jet/v2/internal/jet.QueryWith"))
+	require.False(t, isExecutorFunc("github.com/go-jet/jet/v2/postgres/pgxjet.Query"))
+	require.False(t, isExecutorFunc("main.main"))
+
+	defer func(packagePaths []string) { executorPackagePaths = packagePaths }(executorPackagePaths)
+
+	AddExecutorPackage("github.com/go-jet/jet/v2/postgres/pgxjet")
+
+	require.True(t, isExecutorFunc("github.com/go-jet/jet/v2/postgres/pgxjet.Query"))
+	require.False(t, isExecutorFunc("github.com/go-jet/jet/v2/postgres/pgxjet_test.TestQuery"))
+}
@@ -0,0 +1,142 @@
+package jet
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// BeforeInsertHook can be implemented by model types to modify model values (for instance to normalise fields
+// or to set CreatedAt) before the model values are serialized into INSERT statement MODEL or MODELS rows.
+// Hook is called each time the statement is executed (Exec, Query, Rows, ...), and hook error is returned
+// from the execution. Sql and DebugSql serialize model values without calling the hook.
+type BeforeInsertHook interface {
+	BeforeInsert() error
+}
+
+// BeforeUpdateHook can be implemented by model types to modify model values (for instance to set UpdatedAt)
+// before the model values are serialized into UPDATE statement MODEL or MODELS values.
+// As with BeforeInsertHook, hook is called only on statement execution.
+type BeforeUpdateHook interface {
+	BeforeUpdate() error
+}
+
+var (
+	beforeInsertHookType = reflect.TypeOf((*BeforeInsertHook)(nil)).Elem()
+	beforeUpdateHookType = reflect.TypeOf((*BeforeUpdateHook)(nil)).Elem()
+)
+
+// callModelHook calls model hook for the statement type, if model implements it. Hook is called on a pointer to a new
+// copy of the model, so each execution starts from the model values statement was created with. Returned value is
+// the model value hook was called on.
+func callModelHook(statementType StatementType, structValue reflect.Value) (reflect.Value, error) {
+	structPtrType := reflect.PtrTo(structValue.Type())
+
+	var hookName string
+
+	switch {
+	case statementType == InsertStatementType && structPtrType.Implements(beforeInsertHookType):
+		hookName = "BeforeInsert"
+	case statementType == UpdateStatementType && structPtrType.Implements(beforeUpdateHookType):
+		hookName = "BeforeUpdate"
+	default:
+		return structValue, nil
+	}
+
+	structPtr := reflect.New(structValue.Type())
+	structPtr.Elem().Set(structValue)
+
+	var err error
+
+	if hookName == "BeforeInsert" {
+		err = structPtr.Interface().(BeforeInsertHook).BeforeInsert()
+	} else {
+		err = structPtr.Interface().(BeforeUpdateHook).BeforeUpdate()
+	}
+
+	if err != nil {
+		return structValue, fmt.Errorf("jet: %s hook of %s failed: %w", hookName, structValue.Type().String(), err)
+	}
+
+	return structPtr.Elem(), nil
+}
+
+// modelRow is a copy of the model MODEL and MODELS clause values are read from during statement serialization,
+// so that values reflect the changes model hook made before the statement execution. Model is copied when the
+// statement is created, so later changes of the caller's model do not change the statement.
+type modelRow struct {
+	statementType StatementType
+	structValue   reflect.Value
+}
+
+// modelRowValue is a literal value of the model field for a single column
+type modelRowValue struct {
+	ExpressionInterfaceImpl
+
+	row       *modelRow
+	fieldName string
+	constant  bool
+}
+
+func newModelRowValue(row *modelRow, fieldName string) *modelRowValue {
+	value := &modelRowValue{row: row, fieldName: fieldName}
+	value.ExpressionInterfaceImpl.Parent = value
+
+	return value
+}
+
+// Value returns model field value, without model hook called
+func (m *modelRowValue) Value() interface{} {
+	return modelFieldValue(m.row.structValue, m.fieldName)
+}
+
+func (m *modelRowValue) SetConstant(constant bool) {
+	m.constant = constant
+}
+
+func (m *modelRowValue) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	structValue, err := out.modelHookValue(m.row)
+
+	if err != nil {
+		out.setError(err)
+		return
+	}
+
+	literal(modelFieldValue(structValue, m.fieldName), m.constant).serialize(statement, out, options...)
+}
+
+func modelFieldValue(structValue reflect.Value, fieldName string) interface{} {
+	structField := structValue.FieldByName(fieldName)
+
+	if structField.Kind() == reflect.Ptr && structField.IsNil() {
+		return nil
+	}
+
+	return reflect.Indirect(structField).Interface()
+}
+
+type modelHookResult struct {
+	structValue reflect.Value
+	err         error
+}
+
+// modelHookValue returns model value row values are read from. If statement is serialized for execution, model hook
+// is called once per serialization and the value hook was called on is returned.
+func (s *SQLBuilder) modelHookValue(row *modelRow) (reflect.Value, error) {
+	if !s.callModelHooks {
+		return row.structValue, nil
+	}
+
+	result, ok := s.modelHookResults[row]
+
+	if !ok {
+		result.structValue, result.err = callModelHook(row.statementType, row.structValue)
+
+		if s.modelHookResults == nil {
+			s.modelHookResults = map[*modelRow]modelHookResult{}
+		}
+
+		s.modelHookResults[row] = result
+	}
+
+	return result.structValue, result.err
+}
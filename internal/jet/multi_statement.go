@@ -0,0 +1,100 @@
+package jet
+
+import (
+	"context"
+
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+type multiStatementImpl struct {
+	serializerStatementInterfaceImpl
+
+	Statements []Statement
+	// when true, arguments of the statements are inlined into the query as SQL literals
+	InlineArguments bool
+}
+
+// NewMultiStatement creates new statement that executes the list of statements in a single database round trip.
+// Statements are separated with semicolon, and parametrized arguments are numbered across all the statements.
+// Database driver has to support multi-statement queries, for instance MySQL driver requires multiStatements=true
+// connection parameter.
+func NewMultiStatement(dialect Dialect, statements ...Statement) Statement {
+	if len(statements) == 0 {
+		panic("jet: multi statement requires at least one statement")
+	}
+
+	newMultiStatement := &multiStatementImpl{
+		serializerStatementInterfaceImpl: serializerStatementInterfaceImpl{
+			dialect:       dialect,
+			statementType: "",
+		},
+		Statements: statements,
+	}
+
+	newMultiStatement.parent = newMultiStatement
+
+	return newMultiStatement
+}
+
+// NewInlinedMultiStatement creates new multi statement whose arguments are inlined into the query as SQL literals, for
+// the databases that do not allow multiple statements in a parametrized query.
+func NewInlinedMultiStatement(dialect Dialect, statements ...Statement) Statement {
+	newMultiStatement := NewMultiStatement(dialect, statements...).(*multiStatementImpl)
+	newMultiStatement.InlineArguments = true
+
+	return newMultiStatement
+}
+
+func (s *multiStatementImpl) projections() ProjectionList {
+	return nil
+}
+
+func (s *multiStatementImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if s.InlineArguments && !out.inlineArguments {
+		out.inlineArguments = true
+		defer func() { out.inlineArguments = false }()
+	}
+
+	for i, stmt := range s.Statements {
+		serializer, ok := stmt.(Serializer)
+
+		if !ok {
+			panic("jet: unsupported statement type in multi statement")
+		}
+
+		if i > 0 {
+			out.Buff.WriteByte(';') // written directly, so there is no space before statement separator
+			out.lastChar = ';'
+		}
+
+		serializer.serialize(statement, out, NoWrap)
+	}
+}
+
+// QueryMultiple executes statement returning multiple result sets over database connection/transaction db, and maps
+// each of the result sets into destination at the same index. Statement can be created with NewMultiStatement, or it can
+// be a raw statement returning multiple result sets, for instance a stored procedure call.
+func QueryMultiple(ctx context.Context, statement Statement, db qrm.Queryable, destinations ...interface{}) error {
+	query, args, err := StatementSql(statement)
+
+	if err != nil {
+		return err
+	}
+
+	callLogger(ctx, statement)
+
+	var rowsProcessed int64
+
+	duration := duration(func() {
+		rowsProcessed, err = qrm.QueryMultiple(ctx, db, query, args, destinations...)
+	})
+
+	callQueryLoggerFunc(ctx, QueryInfo{
+		Statement:     statement,
+		RowsProcessed: rowsProcessed,
+		Duration:      duration,
+		Err:           err,
+	})
+
+	return err
+}
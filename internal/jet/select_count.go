@@ -0,0 +1,55 @@
+package jet
+
+import (
+	"context"
+
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// NewCountStatement creates new statement counting the number of rows returned by the select statement built
+// from the list of clauses:
+//
+//	SELECT COUNT(*) AS "count"
+//	FROM (<clauses>) AS count_subquery
+//
+// Clauses should not contain ORDER BY, LIMIT, OFFSET and row lock clauses, because they do not affect
+// the number of rows or are not allowed in sub-queries.
+func NewCountStatement(dialect Dialect, clauses ...Clause) SerializerStatement {
+	subQuery := NewStatementImpl(dialect, SelectStatementType, nil, clauses...).(*statementImpl)
+	subQuery.parent = subQuery
+
+	countStatement := NewStatementImpl(dialect, SelectStatementType, nil,
+		&ClauseSelect{ProjectionList: []Projection{COUNT(STAR).AS("count")}},
+		&ClauseFrom{Tables: []Serializer{NewSelectTable(subQuery, "count_subquery")}},
+	).(*statementImpl)
+	countStatement.parent = countStatement
+
+	return countStatement
+}
+
+// QueryWithCount executes statement over database connection/transaction db and stores row results in destination.
+// Afterwards, countStatement is executed to retrieve the total number of rows statement would return without pagination.
+// countStatement has to return single row with single 'count' column.
+//
+// Statements are executed as two separate queries, so rows inserted or deleted in between by concurrent
+// transactions can make the total count inconsistent with the destination rows. For a consistent result,
+// db should be a transaction with REPEATABLE READ or higher isolation level.
+func QueryWithCount(ctx context.Context, db qrm.Queryable, statement, countStatement Statement, destination interface{}) (totalCount int64, err error) {
+	err = statement.QueryContext(ctx, db, destination)
+
+	if err != nil {
+		return 0, err
+	}
+
+	var dest struct {
+		Count int64
+	}
+
+	err = countStatement.QueryContext(ctx, db, &dest)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return dest.Count, nil
+}
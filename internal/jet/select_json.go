@@ -0,0 +1,208 @@
+package jet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// JSONAggregation holds dialect specific SQL used to aggregate select statement rows into JSON array of objects
+type JSONAggregation struct {
+	// ObjectFunc is the name of the function constructing JSON object from the list of key and value pairs
+	ObjectFunc string
+	// ArrayAgg aggregates JSON objects column into JSON array, %s is a placeholder for the column name.
+	// Aggregation of empty set should return empty JSON array.
+	ArrayAgg string
+	// OrderedArrayAgg aggregates JSON objects column into JSON array in the order of the row number column, first %s
+	// is a placeholder for the objects column name and second for the row number column name. Optional, used
+	// instead of ArrayAgg if aggregated statement has ORDER BY clause.
+	OrderedArrayAgg string
+	// NestedValue wraps nested JSON aggregation statement used as JSON object value, %s is a placeholder for the statement.
+	// Optional, needed only if database does not preserve JSON type of the subquery result.
+	NestedValue string
+	// Unordered is set if ArrayAgg does not preserve the order of aggregated rows, and OrderedArrayAgg is not
+	// supported. ORDER BY clause of aggregated statements is then used only to select the rows for LIMIT and OFFSET.
+	Unordered bool
+}
+
+const (
+	jsonAggRecordsAlias   = "records"
+	jsonAggColumnAlias    = "j"
+	jsonAggRowNumberAlias = "o"
+)
+
+// NewJSONObject creates new expression constructing JSON object from the list of projections. Object keys are projection
+// aliases (for instance 'actor.actor_id'), so that JSON objects can be mapped into destination using the same alias
+// conventions as query result set columns.
+func NewJSONObject(aggregation JSONAggregation, projections ProjectionList) Expression {
+	jsonObject := &jsonObjectExpression{
+		aggregation: aggregation,
+		entries:     newJSONObjectEntries(projections),
+	}
+	jsonObject.ExpressionInterfaceImpl.Parent = jsonObject
+
+	return jsonObject
+}
+
+// NewJSONArrayAggProjection creates select clause projection of the JSON aggregation statement. If aggregation
+// supports ordered aggregation and orderBy clause is set, JSON object is followed by the row number in orderBy order.
+func NewJSONArrayAggProjection(aggregation JSONAggregation, projections ProjectionList, orderBy *ClauseOrderBy) Projection {
+	return &jsonArrayAggProjection{
+		object:      NewJSONObject(aggregation, projections).AS(jsonAggColumnAlias).(*alias),
+		aggregation: aggregation,
+		orderBy:     orderBy,
+	}
+}
+
+type jsonArrayAggProjection struct {
+	object      *alias
+	aggregation JSONAggregation
+	orderBy     *ClauseOrderBy
+}
+
+func (p *jsonArrayAggProjection) fromImpl(subQuery SelectTable) Projection {
+	return p.object.fromImpl(subQuery)
+}
+
+func (p *jsonArrayAggProjection) serializeForProjection(statement StatementType, out *SQLBuilder) {
+	p.object.serializeForProjection(statement, out)
+
+	if !isOrderedJSONArrayAgg(p.aggregation, p.orderBy) {
+		return
+	}
+
+	out.WriteString(",")
+	out.NewLine()
+	out.WriteString("ROW_NUMBER() OVER (")
+	(&ClauseOrderBy{List: p.orderBy.List, SkipNewLine: true}).Serialize(statement, out)
+	out.WriteString(")")
+	out.WriteString("AS")
+	out.WriteAlias(jsonAggRowNumberAlias)
+}
+
+func isOrderedJSONArrayAgg(aggregation JSONAggregation, orderBy *ClauseOrderBy) bool {
+	return aggregation.OrderedArrayAgg != "" && orderBy != nil && len(orderBy.List) > 0
+}
+
+type jsonObjectEntry struct {
+	key   string
+	value Expression
+}
+
+type jsonObjectExpression struct {
+	ExpressionInterfaceImpl
+
+	aggregation JSONAggregation
+	entries     []jsonObjectEntry
+}
+
+func newJSONObjectEntries(projections ProjectionList) []jsonObjectEntry {
+	var ret []jsonObjectEntry
+
+	for _, projection := range projections {
+		switch p := projection.(type) {
+		case ProjectionList:
+			ret = append(ret, newJSONObjectEntries(p)...)
+		case ColumnList:
+			for _, column := range p {
+				ret = append(ret, jsonObjectEntry{key: column.defaultAlias(), value: column})
+			}
+		case ColumnExpression:
+			ret = append(ret, jsonObjectEntry{key: p.defaultAlias(), value: p})
+		case *alias:
+			ret = append(ret, jsonObjectEntry{key: p.alias, value: p.expression})
+		default:
+			panic("jet: unsupported JSON object projection, only columns and aliased expressions are allowed")
+		}
+	}
+
+	if len(ret) == 0 {
+		panic("jet: JSON object has to have at least one projection")
+	}
+
+	return ret
+}
+
+func (j *jsonObjectExpression) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.WriteString(j.aggregation.ObjectFunc + "(")
+	out.IncreaseIdent()
+
+	for i, entry := range j.entries {
+		if i > 0 {
+			out.WriteByte(',')
+		}
+
+		out.NewLine()
+		out.insertConstantArgument(entry.key)
+		out.WriteString(", ")
+
+		if isJSONAggregation(entry.value) && j.aggregation.NestedValue != "" {
+			prefix, suffix, _ := strings.Cut(j.aggregation.NestedValue, "%s")
+			out.WriteString(prefix)
+			entry.value.serialize(statement, out, FallTrough(options)...)
+			out.WriteString(suffix)
+		} else {
+			entry.value.serialize(statement, out, FallTrough(options)...)
+		}
+	}
+
+	out.DecreaseIdent()
+	out.NewLine()
+	out.WriteByte(')')
+}
+
+// isJSONAggregation returns true if expression is JSON aggregation select statement
+func isJSONAggregation(expression Expression) bool {
+	statement, ok := expression.(HasProjections)
+
+	if !ok {
+		return false
+	}
+
+	projections := statement.projections()
+
+	if len(projections) != 1 {
+		return false
+	}
+
+	_, ok = projections[0].(*jsonArrayAggProjection)
+
+	return ok
+}
+
+// ClauseJSONArrayAgg wraps select statement clauses into JSON array aggregation of the statement rows.
+// Aggregated array is wrapped into JSON object under qrm.JSONRowsKey key, so that query result mapping can
+// distinguish aggregated rows from the other JSON documents.
+// Clause is serialized before and after select statement clauses, with End set to false and true.
+// If aggregation supports ordered aggregation, rows are aggregated in the order of OrderBy clause.
+type ClauseJSONArrayAgg struct {
+	Aggregation JSONAggregation
+	OrderBy     *ClauseOrderBy
+	End         bool
+}
+
+// Serialize serializes clause into SQLBuilder
+func (c *ClauseJSONArrayAgg) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if c.End {
+		out.DecreaseIdent()
+		out.NewLine()
+		out.WriteString(") AS")
+		out.WriteIdentifier(jsonAggRecordsAlias)
+		return
+	}
+
+	out.NewLine()
+	out.WriteString("SELECT")
+	out.WriteString(c.Aggregation.ObjectFunc + "(")
+	out.insertConstantArgument(qrm.JSONRowsKey)
+	if isOrderedJSONArrayAgg(c.Aggregation, c.OrderBy) {
+		out.WriteString(", " + fmt.Sprintf(c.Aggregation.OrderedArrayAgg, jsonAggRecordsAlias+"."+jsonAggColumnAlias,
+			jsonAggRecordsAlias+"."+jsonAggRowNumberAlias) + ")")
+	} else {
+		out.WriteString(", " + fmt.Sprintf(c.Aggregation.ArrayAgg, jsonAggRecordsAlias+"."+jsonAggColumnAlias) + ")")
+	}
+	out.NewLine()
+	out.WriteString("FROM (")
+	out.IncreaseIdent()
+}
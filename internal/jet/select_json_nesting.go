@@ -0,0 +1,378 @@
+package jet
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// NestJSONProjections rewrites select statement clauses, so that the projections mapped into destination fields of
+// slice or map of structs type are aggregated into JSON arrays with correlated sub-queries, instead of being
+// joined into the result set. For instance, for destination:
+//
+//	[]struct {
+//		model.Film
+//		Actors []model.Actor
+//	}
+//
+// statement:
+//
+//	SELECT film.*, actor.*
+//	FROM film
+//	     INNER JOIN film_actor ON film_actor.film_id = film.film_id
+//	     INNER JOIN actor ON actor.actor_id = film_actor.actor_id
+//	ORDER BY film.film_id, actor.actor_id
+//
+// is rewritten to:
+//
+//	SELECT film.*,
+//	     (
+//	          <JSON aggregation of>
+//	          SELECT actor.*
+//	          FROM film_actor
+//	               INNER JOIN actor ON actor.actor_id = film_actor.actor_id
+//	          WHERE film_actor.film_id = film.film_id
+//	          ORDER BY actor.actor_id
+//	     ) AS "actors"
+//	FROM film
+//	ORDER BY film.film_id
+//
+// Projections are assigned to the destination nesting levels by the alias prefix (table name or alias). FROM clause
+// has to contain single, left-deep join of tables. Joined tables are moved into the sub-query of the nesting level
+// whose projections reference them, tables without projections (like film_actor above) are moved together with the
+// next table that has. ON condition of the first moved table becomes sub-query WHERE condition, so parent rows without
+// nested rows are not filtered out, regardless of the join type, and nested fields are set to empty JSON arrays.
+// ORDER BY clauses referencing nested tables are moved into the sub-query. Clauses from filters (WHERE, GROUP BY,
+// HAVING) can't reference nested tables.
+//
+// Invalid statements or statements that can't be rewritten cause panic.
+func NestJSONProjections(
+	dialect Dialect,
+	aggregation JSONAggregation,
+	destination interface{},
+	selectClause *ClauseSelect,
+	from *ClauseFrom,
+	orderBy *ClauseOrderBy,
+	filters ...Clause,
+) {
+	root := newJSONNestingLevel(qrm.GetDestinationNesting(destination))
+	levels := root.flatten()
+
+	for _, projection := range flattenProjections(selectClause.ProjectionList) {
+		level := findJSONNestingLevel(levels, projection)
+		level.projections = append(level.projections, projection)
+		level.addTableReferences(referencedTables(dialect, func(out *SQLBuilder) {
+			projection.serializeForProjection(SelectStatementType, out)
+		}))
+	}
+
+	if !root.hasNestedProjections() {
+		return
+	}
+
+	if len(from.Tables) != 1 {
+		panic("jet: JSON nesting requires FROM clause with a single table or join of tables")
+	}
+
+	tableLevels := assignTableSources(levels, flattenJoin(from.Tables[0]))
+
+	for _, filter := range filters {
+		for tableName := range referencedTables(dialect, func(out *SQLBuilder) {
+			filter.Serialize(SelectStatementType, out)
+		}) {
+			if level := tableLevels[tableName]; level != nil && level != root {
+				panic(fmt.Sprintf("jet: WHERE, GROUP BY and HAVING clauses can't reference table '%s' aggregated "+
+					"into JSON nested field '%s'", tableName, level.nesting.Alias))
+			}
+		}
+	}
+
+	for _, orderByClause := range orderBy.List {
+		level := root
+		orderByLevels := map[*jsonNestingLevel]bool{}
+
+		for tableName := range referencedTables(dialect, func(out *SQLBuilder) {
+			orderByClause.serializeForOrderBy(SelectStatementType, out)
+		}) {
+			if tableLevel := tableLevels[tableName]; tableLevel != nil {
+				orderByLevels[tableLevel] = true
+				level = tableLevel
+			}
+		}
+
+		if len(orderByLevels) > 1 {
+			panic("jet: ORDER BY clause can't reference tables from different JSON nesting levels")
+		}
+
+		level.orderBy = append(level.orderBy, orderByClause)
+	}
+
+	selectClause.ProjectionList = root.nestedProjections(dialect, aggregation)
+	from.Tables = []Serializer{joinTableSources(root.tables)}
+
+	if orderBy.List != nil {
+		orderBy.List = root.orderBy
+	}
+}
+
+type jsonNestingLevel struct {
+	nesting  qrm.DestinationNesting
+	children []*jsonNestingLevel
+
+	projections     []Projection
+	tableReferences map[string]bool
+	tables          []joinedTableSource
+	orderBy         []OrderByClause
+}
+
+func newJSONNestingLevel(nesting qrm.DestinationNesting) *jsonNestingLevel {
+	level := &jsonNestingLevel{
+		nesting:         nesting,
+		tableReferences: map[string]bool{},
+	}
+
+	for _, nested := range nesting.Nested {
+		level.children = append(level.children, newJSONNestingLevel(nested))
+	}
+
+	return level
+}
+
+func (l *jsonNestingLevel) flatten() []*jsonNestingLevel {
+	ret := []*jsonNestingLevel{l}
+
+	for _, child := range l.children {
+		ret = append(ret, child.flatten()...)
+	}
+
+	return ret
+}
+
+func (l *jsonNestingLevel) addTableReferences(tableReferences map[string]bool) {
+	for tableName := range tableReferences {
+		l.tableReferences[tableName] = true
+	}
+}
+
+func (l *jsonNestingLevel) hasProjections() bool {
+	return len(l.projections) > 0 || l.hasNestedProjections()
+}
+
+func (l *jsonNestingLevel) hasNestedProjections() bool {
+	for _, child := range l.children {
+		if child.hasProjections() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// nestedProjections returns level projections, followed by the JSON aggregation sub-queries of the nested levels
+func (l *jsonNestingLevel) nestedProjections(dialect Dialect, aggregation JSONAggregation) []Projection {
+	projections := append([]Projection{}, l.projections...)
+
+	for _, child := range l.children {
+		if !child.hasProjections() {
+			continue
+		}
+
+		if len(child.tables) == 0 {
+			panic(fmt.Sprintf("jet: FROM clause does not contain any table of the projections mapped into JSON "+
+				"nested field '%s'", child.nesting.Alias))
+		}
+
+		first := child.tables[0]
+
+		if first.onCondition == nil || (first.joinType != InnerJoin && first.joinType != LeftJoin) {
+			panic(fmt.Sprintf("jet: table '%s' aggregated into JSON nested field '%s' has to be INNER or LEFT "+
+				"joined with ON condition", strings.Join(first.names, ", "), child.nesting.Alias))
+		}
+
+		orderBy := &ClauseOrderBy{List: child.orderBy}
+
+		if aggregation.Unordered {
+			orderBy.List = nil // sub-query has no LIMIT, so ORDER BY would have no effect
+		}
+
+		subQuery := NewExpressionStatementImpl(dialect, SelectStatementType, nil,
+			&ClauseJSONArrayAgg{Aggregation: aggregation, OrderBy: orderBy},
+			&ClauseSelect{ProjectionList: []Projection{
+				NewJSONArrayAggProjection(aggregation, child.nestedProjections(dialect, aggregation), orderBy),
+			}},
+			&ClauseFrom{Tables: []Serializer{joinTableSources(child.tables)}},
+			&ClauseWhere{Condition: first.onCondition},
+			orderBy,
+			&ClauseJSONArrayAgg{Aggregation: aggregation, End: true},
+		).(*expressionStatementImpl)
+		subQuery.ExpressionInterfaceImpl.Parent = subQuery
+		subQuery.parent = subQuery
+
+		projections = append(projections, subQuery.AS(child.nesting.Alias))
+	}
+
+	return projections
+}
+
+// findJSONNestingLevel returns the level projection is mapped into, by the projection alias prefix.
+// Projections without prefix, or with prefix not matching any level, are mapped into the root level.
+func findJSONNestingLevel(levels []*jsonNestingLevel, projection Projection) *jsonNestingLevel {
+	var projectionAlias string
+
+	switch p := projection.(type) {
+	case ColumnExpression:
+		projectionAlias = p.defaultAlias()
+	case *alias:
+		projectionAlias = p.alias
+	}
+
+	typeName, _, found := strings.Cut(projectionAlias, ".")
+
+	if !found {
+		return levels[0]
+	}
+
+	typeName = strings.ToLower(strings.NewReplacer(" ", "", "-", "", "_", "").Replace(typeName))
+
+	var ret *jsonNestingLevel
+
+	for _, level := range levels {
+		for _, levelTypeName := range level.nesting.TypeNames {
+			if levelTypeName != typeName {
+				continue
+			}
+
+			if ret != nil {
+				panic(fmt.Sprintf("jet: projection '%s' can be mapped into more than one JSON nesting level", projectionAlias))
+			}
+
+			ret = level
+		}
+	}
+
+	if ret == nil {
+		return levels[0]
+	}
+
+	return ret
+}
+
+func flattenProjections(projections []Projection) []Projection {
+	var ret []Projection
+
+	for _, projection := range projections {
+		switch p := projection.(type) {
+		case ProjectionList:
+			ret = append(ret, flattenProjections(p)...)
+		case ColumnList:
+			for _, column := range p {
+				ret = append(ret, column)
+			}
+		default:
+			ret = append(ret, projection)
+		}
+	}
+
+	return ret
+}
+
+// joinedTableSource is a table source of the left-deep join of tables, with the join type and condition
+// table source is joined to the preceding table sources.
+type joinedTableSource struct {
+	table       Serializer
+	joinType    JoinType
+	onCondition BoolExpression
+	names       []string
+}
+
+func flattenJoin(table Serializer) []joinedTableSource {
+	joinTable, ok := table.(JoinTable)
+
+	if !ok {
+		return []joinedTableSource{{table: table, names: tableSourceNames(table)}}
+	}
+
+	join := joinTable.join()
+
+	return append(flattenJoin(join.lhs), joinedTableSource{
+		table:       join.rhs,
+		joinType:    join.joinType,
+		onCondition: join.onCondition,
+		names:       tableSourceNames(join.rhs),
+	})
+}
+
+func joinTableSources(tables []joinedTableSource) Serializer {
+	ret := tables[0].table
+
+	for _, table := range tables[1:] {
+		ret = NewJoinTable(ret, table.table, table.joinType, table.onCondition)
+	}
+
+	return ret
+}
+
+// assignTableSources assigns each table source to the nesting level whose projections reference the table source.
+// Table sources not referenced by any projection are assigned to the level of the next assigned table source.
+// Returned map contains the level of each table source name.
+func assignTableSources(levels []*jsonNestingLevel, tables []joinedTableSource) map[string]*jsonNestingLevel {
+	root := levels[0]
+	tableLevels := map[string]*jsonNestingLevel{}
+	var unassigned []joinedTableSource
+
+	for i, table := range tables {
+		var level *jsonNestingLevel
+
+		for _, l := range levels {
+			for _, name := range table.names {
+				if !l.tableReferences[name] || l == level {
+					continue
+				}
+
+				if level != nil {
+					panic(fmt.Sprintf("jet: table '%s' is referenced by projections of more than one JSON nesting level", name))
+				}
+
+				level = l
+			}
+		}
+
+		if i == 0 {
+			if level != nil && level != root {
+				panic("jet: first table of the FROM clause has to be mapped into the destination, not into JSON nested field")
+			}
+
+			level = root
+		}
+
+		if level == nil {
+			unassigned = append(unassigned, table)
+			continue
+		}
+
+		for _, t := range append(unassigned, table) {
+			level.tables = append(level.tables, t)
+
+			for _, name := range t.names {
+				tableLevels[name] = level
+			}
+		}
+
+		unassigned = nil
+	}
+
+	for _, table := range unassigned {
+		root.tables = append(root.tables, table)
+	}
+
+	return tableLevels
+}
+
+// referencedTables returns the names of the tables whose columns are serialized with serialize function
+func referencedTables(dialect Dialect, serialize func(out *SQLBuilder)) map[string]bool {
+	out := &SQLBuilder{Dialect: dialect, Debug: true, tableReferences: map[string]bool{}}
+	serialize(out)
+
+	return out.tableReferences
+}
@@ -1,15 +1,23 @@
 package jet
 
+import (
+	"fmt"
+
+	"github.com/go-jet/jet/v2/internal/utils"
+)
+
 // RowLock is interface for SELECT statement row lock types
 type RowLock interface {
 	Serializer
 
+	OF(tables ...Table) RowLock
 	NOWAIT() RowLock
 	SKIP_LOCKED() RowLock
 }
 
 type selectLockImpl struct {
 	lockStrength       string
+	of                 []Table
 	noWait, skipLocked bool
 }
 
@@ -24,6 +32,11 @@ func newSelectLock(lockStrength string) RowLock {
 	return &selectLockImpl{lockStrength: lockStrength}
 }
 
+func (s *selectLockImpl) OF(tables ...Table) RowLock {
+	s.of = tables
+	return s
+}
+
 func (s *selectLockImpl) NOWAIT() RowLock {
 	s.noWait = true
 	return s
@@ -37,6 +50,18 @@ func (s *selectLockImpl) SKIP_LOCKED() RowLock {
 func (s *selectLockImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
 	out.WriteString(s.lockStrength)
 
+	if len(s.of) > 0 {
+		out.WriteString("OF")
+
+		for i, table := range s.of {
+			if i > 0 {
+				out.WriteString(", ")
+			}
+
+			out.WriteIdentifier(tableReferenceName(table))
+		}
+	}
+
 	if s.noWait {
 		out.WriteString("NOWAIT")
 	}
@@ -45,3 +70,53 @@ func (s *selectLockImpl) serialize(statement StatementType, out *SQLBuilder, opt
 		out.WriteString("SKIP LOCKED")
 	}
 }
+
+// validateRowLockTables panics if any of the row lock OF tables is not referenced in the list of FROM clause tables
+func validateRowLockTables(lock RowLock, fromTables []Serializer) {
+	selectLock, ok := lock.(*selectLockImpl)
+
+	if !ok {
+		return
+	}
+
+	var fromNames []string
+
+	for _, fromTable := range fromTables {
+		fromNames = append(fromNames, tableSourceNames(fromTable)...)
+	}
+
+	for _, table := range selectLock.of {
+		if !utils.StringSliceContains(fromNames, tableReferenceName(table)) {
+			panic(fmt.Sprintf("jet: row lock table '%s' does not appear in FROM clause", tableReferenceName(table)))
+		}
+	}
+}
+
+// tableReferenceName returns the name table is referenced with in the statement, alias or table name if alias is not set
+func tableReferenceName(table Table) string {
+	if table.Alias() != "" {
+		return table.Alias()
+	}
+
+	return table.TableName()
+}
+
+// tableSourceNames returns the list of names table source can be referenced with
+func tableSourceNames(table Serializer) []string {
+	switch t := table.(type) {
+	case JoinTable:
+		var ret []string
+
+		for _, joinedTable := range t.joinedTables() {
+			ret = append(ret, tableSourceNames(joinedTable)...)
+		}
+
+		return ret
+	case Table:
+		return []string{tableReferenceName(t)}
+	case interface{ Alias() string }:
+		return []string{t.Alias()}
+	}
+
+	return nil
+}
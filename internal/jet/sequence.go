@@ -0,0 +1,138 @@
+package jet
+
+// Sequence is interface for database sequence objects
+type Sequence interface {
+	SchemaName() string
+	SequenceName() string
+
+	// NEXTVAL advances the sequence and returns the new value
+	NEXTVAL() IntegerExpression
+	// CURRVAL returns the value most recently obtained by NEXTVAL for this sequence in the current session
+	CURRVAL() IntegerExpression
+	// SETVAL sets the sequence current value, so the next NEXTVAL call returns the value following it
+	SETVAL(value IntegerExpression) IntegerExpression
+}
+
+// SequenceFunctions are dialect specific names of sequence functions
+type SequenceFunctions struct {
+	NextVal string
+	CurrVal string
+	SetVal  string
+
+	// NameLiteral is true if sequence functions accept sequence name as a string literal (PostgreSQL),
+	// instead of sequence identifier (MariaDB).
+	NameLiteral bool
+	// InlineSetValValue is true if SETVAL value is inlined into the query as SQL literal, because SETVAL does not
+	// accept parametrized value (MariaDB).
+	InlineSetValValue bool
+}
+
+type sequenceImpl struct {
+	functions  SequenceFunctions
+	schemaName string
+	name       string
+}
+
+// NewSequence creates new sequence with the schema name and sequence name, using dialect sequence functions
+func NewSequence(functions SequenceFunctions, schemaName, name string) Sequence {
+	return &sequenceImpl{
+		functions:  functions,
+		schemaName: schemaName,
+		name:       name,
+	}
+}
+
+func (s *sequenceImpl) SchemaName() string {
+	return s.schemaName
+}
+
+func (s *sequenceImpl) SequenceName() string {
+	return s.name
+}
+
+func (s *sequenceImpl) NEXTVAL() IntegerExpression {
+	return newIntegerFunc(s.functions.NextVal, s.reference())
+}
+
+func (s *sequenceImpl) CURRVAL() IntegerExpression {
+	return newIntegerFunc(s.functions.CurrVal, s.reference())
+}
+
+func (s *sequenceImpl) SETVAL(value IntegerExpression) IntegerExpression {
+	if s.functions.InlineSetValValue {
+		return newIntegerFunc(s.functions.SetVal, s.reference(), newInlinedArgumentsExpression(value))
+	}
+
+	return newIntegerFunc(s.functions.SetVal, s.reference(), value)
+}
+
+func (s *sequenceImpl) reference() Expression {
+	reference := &sequenceReference{sequence: s}
+	reference.ExpressionInterfaceImpl.Parent = reference
+
+	return reference
+}
+
+// sequenceReference is sequence function argument, serialized as sequence identifier or as string literal
+// containing sequence name
+type sequenceReference struct {
+	ExpressionInterfaceImpl
+
+	sequence *sequenceImpl
+}
+
+func (s *sequenceReference) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if s.sequence.name == "" {
+		panic("jet: sequence name is empty")
+	}
+
+	if !s.sequence.functions.NameLiteral {
+		if s.sequence.schemaName != "" {
+			out.WriteIdentifier(s.sequence.schemaName)
+			out.WriteString(".")
+		}
+
+		out.WriteIdentifier(s.sequence.name)
+		return
+	}
+
+	name := quotedIdentifier(out, s.sequence.name)
+
+	if s.sequence.schemaName != "" {
+		name = quotedIdentifier(out, s.sequence.schemaName) + "." + name
+	}
+
+	out.insertConstantArgument(name)
+}
+
+func quotedIdentifier(out *SQLBuilder, name string) string {
+	if !out.shouldQuote(name) {
+		return name
+	}
+
+	identQuoteChar := string(out.Dialect.IdentifierQuoteChar())
+
+	return identQuoteChar + name + identQuoteChar
+}
+
+// inlinedArgumentsExpression serializes expression with arguments inlined into the query as SQL literals
+type inlinedArgumentsExpression struct {
+	ExpressionInterfaceImpl
+
+	expression Expression
+}
+
+func newInlinedArgumentsExpression(expression Expression) Expression {
+	inlined := &inlinedArgumentsExpression{expression: expression}
+	inlined.ExpressionInterfaceImpl.Parent = inlined
+
+	return inlined
+}
+
+func (i *inlinedArgumentsExpression) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	inlineArguments := out.inlineArguments
+	out.inlineArguments = true
+	defer func() { out.inlineArguments = inlineArguments }()
+
+	i.expression.serialize(statement, out, options...)
+}
@@ -12,6 +12,7 @@ const (
 	fallTroughOptions // fall trough options
 
 	ShortName
+	QualifiedColumnNames // assigned columns are qualified with table name (MySQL multiple-table UPDATE)
 )
 
 // WithFallTrough extends existing serialize options with additional
@@ -24,14 +25,18 @@ type StatementType string
 
 // Statement types
 const (
-	SelectStatementType StatementType = "SELECT"
-	InsertStatementType StatementType = "INSERT"
-	UpdateStatementType StatementType = "UPDATE"
-	DeleteStatementType StatementType = "DELETE"
-	SetStatementType    StatementType = "SET"
-	LockStatementType   StatementType = "LOCK"
-	UnLockStatementType StatementType = "UNLOCK"
-	WithStatementType   StatementType = "WITH"
+	SelectStatementType  StatementType = "SELECT"
+	InsertStatementType  StatementType = "INSERT"
+	UpdateStatementType  StatementType = "UPDATE"
+	DeleteStatementType  StatementType = "DELETE"
+	SetStatementType     StatementType = "SET"
+	LockStatementType    StatementType = "LOCK"
+	UnLockStatementType  StatementType = "UNLOCK"
+	WithStatementType    StatementType = "WITH"
+	DeclareStatementType StatementType = "DECLARE"
+	FetchStatementType   StatementType = "FETCH"
+	MoveStatementType    StatementType = "MOVE"
+	CloseStatementType   StatementType = "CLOSE"
 )
 
 // Serializer interface
@@ -3,6 +3,7 @@ package jet
 import (
 	"bytes"
 	"database/sql/driver"
+	"encoding/hex"
 	"fmt"
 	"github.com/go-jet/jet/v2/internal/3rdparty/pq"
 	"github.com/go-jet/jet/v2/internal/utils"
@@ -13,6 +14,7 @@ import (
 	"strings"
 	"time"
 	"unicode"
+	"unicode/utf8"
 )
 
 // SQLBuilder generates output SQL
@@ -23,8 +25,19 @@ type SQLBuilder struct {
 
 	lastChar byte
 	ident    int
+	err      error
 
 	Debug bool
+
+	// when true, arguments are inlined into the query as SQL literals, instead of being parametrized
+	inlineArguments bool
+
+	// when not nil, names of the tables referenced by serialized columns are collected into the map
+	tableReferences map[string]bool
+
+	// when true, model hooks are called before model values are serialized (statement is serialized for execution)
+	callModelHooks   bool
+	modelHookResults map[*modelRow]modelHookResult
 }
 
 const tabSize = 4
@@ -118,6 +131,14 @@ func (s *SQLBuilder) WriteByte(b byte) {
 	s.write([]byte{b})
 }
 
+// setError records the first error found during serialization. Statement execution returns recorded error instead
+// of executing the statement.
+func (s *SQLBuilder) setError(err error) {
+	if s.err == nil {
+		s.err = err
+	}
+}
+
 func (s *SQLBuilder) finalize() (string, []interface{}) {
 	return s.Buff.String() + ";\n", s.Args
 }
@@ -132,6 +153,11 @@ func (s *SQLBuilder) insertParametrizedArgument(arg interface{}) {
 		return
 	}
 
+	if s.inlineArguments {
+		s.WriteString(s.inlinedArgument(arg))
+		return
+	}
+
 	s.Args = append(s.Args, arg)
 	argPlaceholder := s.Dialect.ArgumentPlaceholder()(len(s.Args))
 
@@ -185,6 +211,12 @@ func (s *SQLBuilder) insertRawQuery(raw string, namedArg map[string]interface{})
 		if !strings.Contains(raw, namedArgumentPos.Name) {
 			continue
 		}
+
+		if s.inlineArguments {
+			raw = strings.Replace(raw, namedArgumentPos.Name, s.inlinedArgument(namedArgumentPos.Value), -1)
+			continue
+		}
+
 		s.Args = append(s.Args, namedArgumentPos.Value)
 		currentArgNum := len(s.Args)
 
@@ -205,6 +237,77 @@ func (s *SQLBuilder) insertRawQuery(raw string, namedArg map[string]interface{})
 	s.WriteString(raw)
 }
 
+// inlinedArgument returns SQL literal of the argument value. Unlike argToString, driver.Valuer value is preferred
+// over fmt.Stringer string representation, and only the types that can be safely written as SQL literal are inlined.
+// For other types, or if Valuer fails, serialization error is recorded.
+func (s *SQLBuilder) inlinedArgument(value interface{}) string {
+	switch value.(type) {
+	case time.Time, uuid.UUID:
+		return argToString(value)
+	}
+
+	if valuer, ok := value.(driver.Valuer); ok && !utils.IsNil(value) {
+		val, err := valuer.Value()
+
+		if err != nil {
+			s.setError(fmt.Errorf("jet: failed to inline argument: %w", err))
+			return "NULL"
+		}
+
+		value = val
+	}
+
+	if utils.IsNil(value) {
+		return "NULL"
+	}
+
+	switch bindVal := value.(type) {
+	case bool, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64, time.Time:
+		return argToString(bindVal)
+	case string:
+		if literal, ok := s.inlinedString(bindVal); ok {
+			return literal
+		}
+	case []byte:
+		switch s.dialectName() {
+		case "PostgreSQL":
+			return `'\x` + hex.EncodeToString(bindVal) + `'::bytea`
+		case "MySQL", "SQLite":
+			return `X'` + hex.EncodeToString(bindVal) + `'`
+		}
+	}
+
+	s.setError(fmt.Errorf("jet: argument of type %T can not be inlined", value))
+
+	return "NULL"
+}
+
+// inlinedString returns quoted string literal, if string can be inlined regardless of the database string
+// escape settings. PostgreSQL strings containing backslash are written as escape string constants (E'...').
+func (s *SQLBuilder) inlinedString(value string) (string, bool) {
+	if !utf8.ValidString(value) || strings.ContainsRune(value, 0) {
+		return "", false
+	}
+
+	if !strings.Contains(value, `\`) {
+		return stringQuote(value), true
+	}
+
+	if s.dialectName() == "PostgreSQL" {
+		return "E" + stringQuote(strings.Replace(value, `\`, `\\`, -1)), true
+	}
+
+	return "", false
+}
+
+func (s *SQLBuilder) dialectName() string {
+	if s.Dialect == nil {
+		return ""
+	}
+
+	return s.Dialect.Name()
+}
+
 func argToString(value interface{}) string {
 	if utils.IsNil(value) {
 		return "NULL"
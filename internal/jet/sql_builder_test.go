@@ -1,6 +1,9 @@
 package jet
 
 import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"testing"
@@ -42,6 +45,44 @@ func TestArgToString(t *testing.T) {
 	}()
 }
 
+type failingValuer struct{}
+
+func (f failingValuer) Value() (driver.Value, error) {
+	return nil, errors.New("invalid value")
+}
+
+func TestInlinedArgument(t *testing.T) {
+	out := &SQLBuilder{}
+
+	require.Equal(t, "'It''s text'", out.inlinedArgument("It's text"))
+	require.Equal(t, "NULL", out.inlinedArgument(sql.NullString{}))
+	require.Equal(t, "'text'", out.inlinedArgument(sql.NullString{String: "text", Valid: true}))
+	require.NoError(t, out.err)
+
+	require.Equal(t, "NULL", out.inlinedArgument(failingValuer{}))
+	require.EqualError(t, out.err, "jet: failed to inline argument: invalid value")
+
+	out = &SQLBuilder{Dialect: NewDialect(DialectParams{Name: "PostgreSQL"})}
+
+	require.Equal(t, `'\x00ff'::bytea`, out.inlinedArgument([]byte{0, 255}))
+	require.Equal(t, `E'C:\\dir'`, out.inlinedArgument(`C:\dir`))
+	require.NoError(t, out.err)
+
+	require.Equal(t, "NULL", out.inlinedArgument(string([]byte{0xff})))
+	require.EqualError(t, out.err, "jet: argument of type string can not be inlined")
+
+	out = &SQLBuilder{Dialect: NewDialect(DialectParams{Name: "MySQL"})}
+
+	require.Equal(t, `X'00ff'`, out.inlinedArgument([]byte{0, 255}))
+	require.Equal(t, "NULL", out.inlinedArgument(`C:\dir`))
+	require.EqualError(t, out.err, "jet: argument of type string can not be inlined")
+
+	out = &SQLBuilder{}
+
+	require.Equal(t, "NULL", out.inlinedArgument(struct{}{}))
+	require.EqualError(t, out.err, "jet: argument of type struct {} can not be inlined")
+}
+
 func TestFallTrough(t *testing.T) {
 	require.Equal(t, FallTrough([]SerializeOption{ShortName}), []SerializeOption{ShortName})
 	require.Equal(t, FallTrough([]SerializeOption{SkipNewLine}), []SerializeOption(nil))
@@ -35,6 +35,8 @@ type Rows struct {
 	*sql.Rows
 
 	scanContext *qrm.ScanContext
+	strictScan  bool
+	err         error
 }
 
 // Scan will map the Row values into struct destination
@@ -42,6 +44,37 @@ func (r *Rows) Scan(destination interface{}) error {
 	return qrm.ScanOneRowToDest(r.scanContext, r.Rows, destination)
 }
 
+// NextResultSet prepares the next result set for reading, and resets row mapping for the new result set columns.
+// It reports whether there is further result set, or false if there is no further result set or if there is an error
+// advancing to it. The Err method should be consulted to distinguish between the two cases.
+func (r *Rows) NextResultSet() bool {
+	if !r.Rows.NextResultSet() {
+		return false
+	}
+
+	scanContext, err := qrm.NewScanContext(r.Rows)
+
+	if err != nil {
+		r.err = err
+		return false
+	}
+
+	scanContext.SetStrictScan(r.strictScan)
+	r.scanContext = scanContext
+
+	return true
+}
+
+// Err returns the error, if any, that was encountered during iteration or while preparing the row mapping
+// of the next result set.
+func (r *Rows) Err() error {
+	if r.err != nil {
+		return r.err
+	}
+
+	return r.Rows.Err()
+}
+
 // SerializerStatement interface
 type SerializerStatement interface {
 	Serializer
@@ -68,13 +101,22 @@ type serializerStatementInterfaceImpl struct {
 }
 
 func (s *serializerStatementInterfaceImpl) Sql() (query string, args []interface{}) {
-
 	queryData := &SQLBuilder{Dialect: s.dialect}
 
 	s.parent.serialize(s.statementType, queryData, NoWrap)
 
+	return queryData.finalize()
+}
+
+// sql returns parametrized sql query and list of arguments for statement execution. Model hooks are called before
+// model values are serialized, and the first hook error is returned.
+func (s *serializerStatementInterfaceImpl) sql() (query string, args []interface{}, err error) {
+	queryData := &SQLBuilder{Dialect: s.dialect, callModelHooks: true}
+
+	s.parent.serialize(s.statementType, queryData, NoWrap)
+
 	query, args = queryData.finalize()
-	return
+	return query, args, queryData.err
 }
 
 func (s *serializerStatementInterfaceImpl) DebugSql() (query string) {
@@ -91,12 +133,15 @@ func (s *serializerStatementInterfaceImpl) Query(db qrm.Queryable, destination i
 }
 
 func (s *serializerStatementInterfaceImpl) QueryContext(ctx context.Context, db qrm.Queryable, destination interface{}) error {
-	query, args := s.Sql()
+	query, args, err := s.sql()
+
+	if err != nil {
+		return err
+	}
 
 	callLogger(ctx, s)
 
 	var rowsProcessed int64
-	var err error
 
 	duration := duration(func() {
 		rowsProcessed, err = qrm.Query(ctx, db, query, args, destination)
@@ -117,7 +162,11 @@ func (s *serializerStatementInterfaceImpl) Exec(db qrm.Executable) (res sql.Resu
 }
 
 func (s *serializerStatementInterfaceImpl) ExecContext(ctx context.Context, db qrm.Executable) (res sql.Result, err error) {
-	query, args := s.Sql()
+	query, args, err := s.sql()
+
+	if err != nil {
+		return nil, err
+	}
 
 	callLogger(ctx, s)
 
@@ -142,12 +191,15 @@ func (s *serializerStatementInterfaceImpl) ExecContext(ctx context.Context, db q
 }
 
 func (s *serializerStatementInterfaceImpl) Rows(ctx context.Context, db qrm.Queryable) (*Rows, error) {
-	query, args := s.Sql()
+	query, args, err := s.sql()
+
+	if err != nil {
+		return nil, err
+	}
 
 	callLogger(ctx, s)
 
 	var rows *sql.Rows
-	var err error
 
 	duration := duration(func() {
 		rows, err = db.QueryContext(ctx, query, args...)
@@ -169,9 +221,12 @@ func (s *serializerStatementInterfaceImpl) Rows(ctx context.Context, db qrm.Quer
 		return nil, err
 	}
 
+	scanContext.SetStrictScan(qrm.IsStrictScan(ctx))
+
 	return &Rows{
 		Rows:        rows,
 		scanContext: scanContext,
+		strictScan:  qrm.IsStrictScan(ctx),
 	}, nil
 }
 
@@ -0,0 +1,129 @@
+package jet
+
+import (
+	"context"
+
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// StatementSql returns parametrized sql query and list of arguments for the statement execution, or an error found
+// during statement serialization (for instance an error returned from model hook).
+func StatementSql(statement Statement) (query string, args []interface{}, err error) {
+	statementSql, ok := statement.(interface {
+		sql() (query string, args []interface{}, err error)
+	})
+
+	if !ok {
+		query, args = statement.Sql()
+		return query, args, nil
+	}
+
+	return statementSql.sql()
+}
+
+// QueryFunc executes sql query with list of arguments and returns result rows
+type QueryFunc func(ctx context.Context, query string, args []interface{}) (qrm.RowSource, error)
+
+// ExecFunc executes sql query with list of arguments and returns the number of rows affected
+type ExecFunc func(ctx context.Context, query string, args []interface{}) (rowsAffected int64, err error)
+
+// QueryWith executes statement using queryFunc and stores row results in destination. It is used to execute statements
+// over database connections not based on database/sql, for instance pgx connection pool.
+func QueryWith(ctx context.Context, statement Statement, queryFunc QueryFunc, destination interface{}) error {
+	query, args, err := StatementSql(statement)
+
+	if err != nil {
+		return err
+	}
+
+	callLogger(ctx, statement)
+
+	var rowsProcessed int64
+
+	duration := duration(func() {
+		var rows qrm.RowSource
+
+		rows, err = queryFunc(ctx, query, args)
+
+		if err != nil {
+			return
+		}
+
+		rowsProcessed, err = qrm.ScanRows(ctx, rows, destination)
+	})
+
+	callQueryLoggerFunc(ctx, QueryInfo{
+		Statement:     statement,
+		RowsProcessed: rowsProcessed,
+		Duration:      duration,
+		Err:           err,
+	})
+
+	return err
+}
+
+// ExecWith executes statement using execFunc. It is used to execute statements over database connections
+// not based on database/sql, for instance pgx connection pool.
+func ExecWith(ctx context.Context, statement Statement, execFunc ExecFunc) error {
+	query, args, err := StatementSql(statement)
+
+	if err != nil {
+		return err
+	}
+
+	callLogger(ctx, statement)
+
+	var rowsAffected int64
+
+	duration := duration(func() {
+		rowsAffected, err = execFunc(ctx, query, args)
+	})
+
+	callQueryLoggerFunc(ctx, QueryInfo{
+		Statement:     statement,
+		RowsProcessed: rowsAffected,
+		Duration:      duration,
+		Err:           err,
+	})
+
+	return err
+}
+
+// RowsWith executes statement using queryFunc and returns result rows, with a scan context for mapping rows into
+// destinations one at a time with qrm.ScanOneRowToDest.
+func RowsWith(ctx context.Context, statement Statement, queryFunc QueryFunc) (qrm.RowSource, *qrm.ScanContext, error) {
+	query, args, err := StatementSql(statement)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	callLogger(ctx, statement)
+
+	var rows qrm.RowSource
+
+	duration := duration(func() {
+		rows, err = queryFunc(ctx, query, args)
+	})
+
+	callQueryLoggerFunc(ctx, QueryInfo{
+		Statement: statement,
+		Duration:  duration,
+		Err:       err,
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scanContext, err := qrm.NewScanContext(rows)
+
+	if err != nil {
+		rows.Close()
+		return nil, nil, err
+	}
+
+	scanContext.SetStrictScan(qrm.IsStrictScan(ctx))
+
+	return rows, scanContext, nil
+}
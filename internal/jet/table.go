@@ -13,6 +13,7 @@ type SerializerTable interface {
 // Table interface
 type Table interface {
 	columns() []Column
+	primaryKeys() []ColumnExpression
 	SchemaName() string
 	TableName() string
 	Alias() string
@@ -20,12 +21,18 @@ type Table interface {
 
 // NewTable creates new table with schema Name, table Name and list of columns
 func NewTable(schemaName, name, alias string, columns ...ColumnExpression) SerializerTable {
+	return NewTableWithPrimaryKey(schemaName, name, alias, nil, columns...)
+}
+
+// NewTableWithPrimaryKey creates new table with schema Name, table Name, list of primary key columns and list of columns
+func NewTableWithPrimaryKey(schemaName, name, alias string, primaryKey []ColumnExpression, columns ...ColumnExpression) SerializerTable {
 
 	t := tableImpl{
-		schemaName: schemaName,
-		name:       name,
-		alias:      alias,
-		columnList: columns,
+		schemaName:     schemaName,
+		name:           name,
+		alias:          alias,
+		columnList:     columns,
+		primaryKeyList: primaryKey,
 	}
 
 	columnTableName := name
@@ -42,10 +49,17 @@ func NewTable(schemaName, name, alias string, columns ...ColumnExpression) Seria
 }
 
 type tableImpl struct {
-	schemaName string
-	name       string
-	alias      string
-	columnList []ColumnExpression
+	schemaName     string
+	name           string
+	alias          string
+	columnList     []ColumnExpression
+	primaryKeyList []ColumnExpression
+}
+
+// PrimaryKeys returns list of table primary key columns. Join tables and tables created without
+// primary key information return empty list.
+func PrimaryKeys(table Table) []ColumnExpression {
+	return table.primaryKeys()
 }
 
 func (t *tableImpl) SchemaName() string {
@@ -66,6 +80,10 @@ func (t *tableImpl) columns() []Column {
 	return ret
 }
 
+func (t *tableImpl) primaryKeys() []ColumnExpression {
+	return t.primaryKeyList
+}
+
 func (t *tableImpl) Alias() string {
 	return t.alias
 }
@@ -110,7 +128,12 @@ type joinTableImpl struct {
 }
 
 // JoinTable interface
-type JoinTable SerializerTable
+type JoinTable interface {
+	SerializerTable
+
+	joinedTables() []Serializer
+	join() *joinTableImpl
+}
 
 // NewJoinTable creates new join table
 func NewJoinTable(lhs Serializer, rhs Serializer, joinType JoinType, onCondition BoolExpression) JoinTable {
@@ -132,6 +155,14 @@ func (t *joinTableImpl) SchemaName() string {
 	return ""
 }
 
+func (t *joinTableImpl) joinedTables() []Serializer {
+	return []Serializer{t.lhs, t.rhs}
+}
+
+func (t *joinTableImpl) join() *joinTableImpl {
+	return t
+}
+
 func (t *joinTableImpl) TableName() string {
 	return ""
 }
@@ -149,6 +180,10 @@ func (t *joinTableImpl) columns() []Column {
 	return ret
 }
 
+func (t *joinTableImpl) primaryKeys() []ColumnExpression {
+	return nil
+}
+
 func (t *joinTableImpl) Alias() string {
 	return ""
 }
@@ -194,3 +229,47 @@ func (t *joinTableImpl) serialize(statement StatementType, out *SQLBuilder, opti
 		t.onCondition.serialize(statement, out)
 	}
 }
+
+// Table sample is readable table returning random subset of the table rows
+type tableSampleImpl struct {
+	SerializerTable
+	method     string
+	percentage Expression
+	seed       Expression
+}
+
+// NewTableSample creates new table sample of the table rows using sampling method and percentage of rows to return.
+// Seed is optional (can be nil) random number generator seed of REPEATABLE clause.
+func NewTableSample(table SerializerTable, method string, percentage, seed Expression) SerializerTable {
+	return &tableSampleImpl{
+		SerializerTable: table,
+		method:          method,
+		percentage:      percentage,
+		seed:            seed,
+	}
+}
+
+func (t *tableSampleImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if utils.IsNil(t.SerializerTable) {
+		panic("jet: table sample table is nil")
+	}
+
+	if t.percentage == nil {
+		panic("jet: table sample percentage is nil")
+	}
+
+	t.SerializerTable.serialize(statement, out, FallTrough(options)...)
+
+	out.WriteString("TABLESAMPLE")
+	out.WriteString(t.method)
+	out.WriteString("(")
+	t.percentage.serialize(statement, out)
+	out.WriteByte(')')
+
+	if t.seed != nil {
+		out.WriteString("REPEATABLE")
+		out.WriteString("(")
+		t.seed.serialize(statement, out)
+		out.WriteByte(')')
+	}
+}
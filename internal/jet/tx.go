@@ -0,0 +1,214 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// Tx is database transaction started by WithTx. Tx can be used as qrm.DB for statement execution, or passed to
+// WithTx again to start nested transaction (savepoint).
+type Tx struct {
+	*sql.Tx
+
+	dialect Dialect
+	depth   int
+}
+
+// TxOptions holds options for WithTx
+type TxOptions struct {
+	// Isolation and ReadOnly are passed to database/sql when transaction is started
+	Isolation sql.IsolationLevel
+	ReadOnly  bool
+
+	// MaxRetries is the maximum number of times transaction is retried after serialization failure or deadlock
+	MaxRetries int
+	// Backoff returns duration to wait before retry attempt. If not set, exponential backoff with jitter is used.
+	Backoff func(attempt int) time.Duration
+	// IsRetryable reports whether transaction should be retried after error. If not set, IsRetryableTxError is used.
+	IsRetryable func(err error) bool
+}
+
+// DefaultTxOptions are used by WithTx when options are not specified
+var DefaultTxOptions = TxOptions{
+	MaxRetries: 3,
+}
+
+type txBeginner interface {
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+// WithTx executes fn inside database transaction. Transaction is committed if fn returns nil, otherwise it is rolled
+// back and fn error is returned. If db is *sql.DB or *sql.Conn new transaction is started, and if transaction fails
+// with serialization failure or deadlock whole transaction is retried with backoff. If db is already a transaction
+// (*Tx or *sql.Tx), fn is executed inside nested savepoint, which is released on success or rolled back on error.
+func WithTx(ctx context.Context, dialect Dialect, db qrm.Executable, opts *TxOptions, fn func(tx *Tx) error) error {
+	switch d := db.(type) {
+	case *Tx:
+		return withSavepoint(ctx, d, fn)
+	case *sql.Tx:
+		return withSavepoint(ctx, &Tx{Tx: d, dialect: dialect}, fn)
+	case txBeginner:
+		if opts == nil {
+			opts = &DefaultTxOptions
+		}
+		return withRetries(ctx, dialect, d, opts, fn)
+	default:
+		return fmt.Errorf("jet: %T does not support transactions", db)
+	}
+}
+
+func withRetries(ctx context.Context, dialect Dialect, db txBeginner, opts *TxOptions, fn func(tx *Tx) error) error {
+	isRetryable := opts.IsRetryable
+	if isRetryable == nil {
+		isRetryable = IsRetryableTxError
+	}
+
+	backoff := opts.Backoff
+	if backoff == nil {
+		backoff = exponentialBackoff
+	}
+
+	for attempt := 0; ; attempt++ {
+		err := withTx(ctx, dialect, db, opts, fn)
+
+		if err == nil || attempt >= opts.MaxRetries || !isRetryable(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff(attempt)):
+		}
+	}
+}
+
+func withTx(ctx context.Context, dialect Dialect, db txBeginner, opts *TxOptions, fn func(tx *Tx) error) (err error) {
+	sqlTx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: opts.Isolation, ReadOnly: opts.ReadOnly})
+
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = sqlTx.Rollback()
+			panic(p)
+		}
+	}()
+
+	err = fn(&Tx{Tx: sqlTx, dialect: dialect})
+
+	if err != nil {
+		_ = sqlTx.Rollback()
+		return err
+	}
+
+	return sqlTx.Commit()
+}
+
+func withSavepoint(ctx context.Context, tx *Tx, fn func(tx *Tx) error) (err error) {
+	nestedTx := &Tx{Tx: tx.Tx, dialect: tx.dialect, depth: tx.depth + 1}
+	savepoint := fmt.Sprintf("jet_savepoint_%d", nestedTx.depth)
+
+	if _, err := savepointStatement(tx.dialect, "SAVEPOINT", savepoint).ExecContext(ctx, tx.Tx); err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = rollbackToSavepoint(ctx, tx, savepoint)
+			panic(p)
+		}
+	}()
+
+	err = fn(nestedTx)
+
+	if err != nil {
+		if rollbackErr := rollbackToSavepoint(ctx, tx, savepoint); rollbackErr != nil {
+			return fmt.Errorf("%w, rollback to savepoint failed: %s", err, rollbackErr)
+		}
+		return err
+	}
+
+	_, err = savepointStatement(tx.dialect, "RELEASE SAVEPOINT", savepoint).ExecContext(ctx, tx.Tx)
+
+	return err
+}
+
+func rollbackToSavepoint(ctx context.Context, tx *Tx, savepoint string) error {
+	if _, err := savepointStatement(tx.dialect, "ROLLBACK TO SAVEPOINT", savepoint).ExecContext(ctx, tx.Tx); err != nil {
+		return err
+	}
+
+	_, err := savepointStatement(tx.dialect, "RELEASE SAVEPOINT", savepoint).ExecContext(ctx, tx.Tx)
+
+	return err
+}
+
+func savepointStatement(dialect Dialect, command, savepoint string) Statement {
+	out := SQLBuilder{Dialect: dialect}
+	out.WriteString(command)
+	out.WriteIdentifier(savepoint)
+
+	return RawStatement(dialect, out.Buff.String())
+}
+
+func exponentialBackoff(attempt int) time.Duration {
+	backoff := 10 * time.Millisecond << uint(attempt)
+
+	if backoff > time.Second {
+		backoff = time.Second
+	}
+
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+// IsRetryableTxError reports whether err is a transaction serialization failure or deadlock, after which transaction
+// can be retried: PostgreSQL serialization_failure (40001) and deadlock_detected (40P01), CockroachDB transaction
+// restart errors, and MySQL deadlock error (1213).
+func IsRetryableTxError(err error) bool {
+	for ; err != nil; err = errors.Unwrap(err) {
+		if sqlStateErr, ok := err.(interface{ SQLState() string }); ok {
+			switch sqlStateErr.SQLState() {
+			case "40001", "40P01":
+				return true
+			}
+		}
+
+		if strings.Contains(err.Error(), "restart transaction") {
+			return true // CockroachDB
+		}
+
+		if mysqlErrorNumber(err) == 1213 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mysqlErrorNumber returns the Number field of go-sql-driver MySQLError, without importing MySQL driver
+func mysqlErrorNumber(err error) uint64 {
+	errValue := reflect.Indirect(reflect.ValueOf(err))
+
+	if errValue.Kind() != reflect.Struct {
+		return 0
+	}
+
+	number := errValue.FieldByName("Number")
+
+	if !number.IsValid() || number.Kind() != reflect.Uint16 {
+		return 0
+	}
+
+	return number.Uint()
+}
@@ -0,0 +1,40 @@
+package jet
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type sqlStateError string
+
+func (s sqlStateError) Error() string    { return "sql state " + string(s) }
+func (s sqlStateError) SQLState() string { return string(s) }
+
+// same shape as go-sql-driver MySQLError
+type mySQLError struct {
+	Number  uint16
+	Message string
+}
+
+func (m *mySQLError) Error() string { return m.Message }
+
+func TestIsRetryableTxError(t *testing.T) {
+	require.False(t, IsRetryableTxError(nil))
+	require.False(t, IsRetryableTxError(errors.New("some error")))
+	require.False(t, IsRetryableTxError(sqlStateError("23505")))
+	require.False(t, IsRetryableTxError(&mySQLError{Number: 1062, Message: "Duplicate entry"}))
+
+	require.True(t, IsRetryableTxError(sqlStateError("40001")))
+	require.True(t, IsRetryableTxError(sqlStateError("40P01")))
+	require.True(t, IsRetryableTxError(errors.New("restart transaction: TransactionRetryWithProtoRefreshError")))
+	require.True(t, IsRetryableTxError(&mySQLError{Number: 1213, Message: "Deadlock found when trying to get lock"}))
+	require.True(t, IsRetryableTxError(fmt.Errorf("wrapped: %w", sqlStateError("40001"))))
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	require.InDelta(t, 7500000, int64(exponentialBackoff(0)), 2500000)
+	require.InDelta(t, 750000000, int64(exponentialBackoff(10)), 250000000)
+}
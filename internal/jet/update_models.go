@@ -0,0 +1,129 @@
+package jet
+
+import "errors"
+
+// UpdateModelsAlias is alias of the derived table, containing new model values, in UPDATE MODELS statements
+const UpdateModelsAlias = "v"
+
+// UpdateModels struct contains list of models values to be updated, keyed on table primary key columns
+type UpdateModels struct {
+	Table       SerializerTable
+	PrimaryKeys []Column
+	Columns     []Column
+	Rows        [][]Serializer
+}
+
+// NewUpdateModels creates new UpdateModels from table primary key columns, list of columns to update, and slice of models
+func NewUpdateModels(table SerializerTable, columns []Column, data interface{}) *UpdateModels {
+	primaryKeys := PrimaryKeys(table)
+
+	if len(primaryKeys) == 0 {
+		panic("jet: UPDATE MODELS requires table with primary key columns")
+	}
+
+	ret := &UpdateModels{Table: table}
+
+	for _, primaryKey := range primaryKeys {
+		ret.PrimaryKeys = append(ret.PrimaryKeys, primaryKey)
+	}
+
+	for _, column := range columns {
+		if !containsColumn(ret.PrimaryKeys, column) {
+			ret.Columns = append(ret.Columns, column)
+		}
+	}
+
+	if len(ret.Columns) == 0 {
+		panic("jet: no columns selected")
+	}
+
+	ret.Rows = UnwindRowsFromModels(UpdateStatementType, ret.ValueColumns(), data)
+
+	return ret
+}
+
+// ValueColumns returns list of primary key columns followed by list of columns to update
+func (m *UpdateModels) ValueColumns() []Column {
+	var ret []Column
+	ret = append(ret, m.PrimaryKeys...)
+	return append(ret, m.Columns...)
+}
+
+// WithRows returns copy of update models with new list of rows
+func (m *UpdateModels) WithRows(rows [][]Serializer) *UpdateModels {
+	ret := *m
+	ret.Rows = rows
+	return &ret
+}
+
+// CheckRows records serialization error if there are no model rows, for the dialects where UPDATE MODELS statement
+// without rows is not a valid SQL.
+func (m *UpdateModels) CheckRows(out *SQLBuilder) {
+	if len(m.Rows) == 0 {
+		out.setError(errors.New("jet: UPDATE MODELS has no rows, models slice is empty"))
+	}
+}
+
+// SerializeAssignments serializes list of column assignments from new model values (col = v.col)
+func (m *UpdateModels) SerializeAssignments(out *SQLBuilder, qualified bool) {
+	out.IncreaseIdent(4)
+
+	for i, column := range m.Columns {
+		if i > 0 {
+			out.WriteString(",")
+			out.NewLine()
+		}
+
+		if qualified {
+			serializeQualifiedColumnName(column, out)
+		} else {
+			out.WriteIdentifier(column.Name())
+		}
+
+		out.WriteString("=")
+		out.WriteIdentifier(UpdateModelsAlias)
+		out.WriteByte('.')
+		out.WriteIdentifier(column.Name())
+	}
+
+	out.DecreaseIdent(4)
+}
+
+// SerializeCondition serializes primary key match condition (table.pk = v.pk) followed by optional additional condition
+func (m *UpdateModels) SerializeCondition(statementType StatementType, out *SQLBuilder, condition BoolExpression) {
+	for i, primaryKey := range m.PrimaryKeys {
+		if i > 0 {
+			out.WriteString("AND")
+		}
+
+		serializeQualifiedColumnName(primaryKey, out)
+		out.WriteString("=")
+		out.WriteIdentifier(UpdateModelsAlias)
+		out.WriteByte('.')
+		out.WriteIdentifier(primaryKey.Name())
+	}
+
+	if condition != nil {
+		out.WriteString("AND")
+		condition.serialize(statementType, out)
+	}
+}
+
+func serializeQualifiedColumnName(column Column, out *SQLBuilder) {
+	if column.TableName() != "" {
+		out.WriteIdentifier(column.TableName())
+		out.WriteByte('.')
+	}
+
+	out.WriteIdentifier(column.Name())
+}
+
+func containsColumn(columns []Column, column Column) bool {
+	for _, c := range columns {
+		if c.Name() == column.Name() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -145,48 +145,44 @@ func ToSerializerValue(value interface{}) Serializer {
 }
 
 // UnwindRowFromModel func
-func UnwindRowFromModel(columns []Column, data interface{}) []Serializer {
-	structValue := reflect.Indirect(reflect.ValueOf(data))
-
-	row := []Serializer{}
+func UnwindRowFromModel(statementType StatementType, columns []Column, data interface{}) []Serializer {
+	return unwindRowFromModel(statementType, columns, reflect.Indirect(reflect.ValueOf(data)))
+}
 
+func unwindRowFromModel(statementType StatementType, columns []Column, structValue reflect.Value) []Serializer {
 	utils.ValueMustBe(structValue, reflect.Struct, "jet: data has to be a struct")
 
+	modelCopy := reflect.New(structValue.Type()).Elem()
+	modelCopy.Set(structValue)
+
+	model := &modelRow{statementType: statementType, structValue: modelCopy}
+	row := []Serializer{}
+
 	for _, column := range columns {
 		columnName := column.Name()
 		structFieldName := utils.ToGoIdentifier(columnName)
 
-		structField := structValue.FieldByName(structFieldName)
-
-		if !structField.IsValid() {
+		if !structValue.FieldByName(structFieldName).IsValid() {
 			panic("missing struct field for column : " + columnName)
 		}
 
-		var field interface{}
-
-		if structField.Kind() == reflect.Ptr && structField.IsNil() {
-			field = nil
-		} else {
-			field = reflect.Indirect(structField).Interface()
-		}
-
-		row = append(row, literal(field))
+		row = append(row, newModelRowValue(model, structFieldName))
 	}
 
 	return row
 }
 
 // UnwindRowsFromModels func
-func UnwindRowsFromModels(columns []Column, data interface{}) [][]Serializer {
+func UnwindRowsFromModels(statementType StatementType, columns []Column, data interface{}) [][]Serializer {
 	sliceValue := reflect.Indirect(reflect.ValueOf(data))
 	utils.ValueMustBe(sliceValue, reflect.Slice, "jet: data has to be a slice.")
 
 	rows := [][]Serializer{}
 
 	for i := 0; i < sliceValue.Len(); i++ {
-		structValue := sliceValue.Index(i)
+		structValue := reflect.Indirect(sliceValue.Index(i))
 
-		rows = append(rows, UnwindRowFromModel(columns, structValue.Interface()))
+		rows = append(rows, unwindRowFromModel(statementType, columns, structValue))
 	}
 
 	return rows
@@ -0,0 +1,187 @@
+package jet
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ValuesTableOptions are dialect specific options of VALUES table serialization
+type ValuesTableOptions struct {
+	// RowConstructor is written before each row value list, for instance ROW for MySQL
+	RowConstructor string
+	// SelectColumns is set for dialects not supporting derived table column list (SQLite). VALUES are wrapped with
+	// SELECT statement renaming default column names (column1, column2, ...) into alias column names.
+	SelectColumns bool
+}
+
+type valuesStatement struct {
+	options ValuesTableOptions
+	rows    [][]Serializer
+	columns []ColumnExpression
+}
+
+func (v *valuesStatement) projections() ProjectionList {
+	var ret ProjectionList
+
+	for _, column := range v.columns {
+		ret = append(ret, column)
+	}
+
+	return ret
+}
+
+func (v *valuesStatement) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if len(v.rows) == 0 {
+		out.setError(errors.New("jet: VALUES table has no rows, models slice is empty"))
+	}
+
+	out.WriteString("(")
+	out.IncreaseIdent()
+
+	if v.options.SelectColumns {
+		out.NewLine()
+		out.WriteString("SELECT")
+		out.IncreaseIdent()
+
+		for i, column := range v.columns {
+			if i > 0 {
+				out.WriteString(",")
+				out.NewLine()
+			}
+
+			out.WriteString(fmt.Sprintf("column%d AS", i+1))
+			out.WriteIdentifier(column.Name())
+		}
+
+		out.DecreaseIdent()
+
+		out.NewLine()
+		out.WriteString("FROM (")
+		out.IncreaseIdent()
+	}
+
+	out.NewLine()
+	out.WriteString("VALUES")
+
+	for rowIndex, row := range v.rows {
+		if rowIndex > 0 {
+			out.WriteString(",")
+			out.NewLine()
+		} else {
+			out.IncreaseIdent(7)
+		}
+
+		out.WriteString(v.options.RowConstructor + "(")
+		SerializeClauseList(statement, row, out)
+		out.WriteByte(')')
+	}
+
+	if len(v.rows) > 0 {
+		out.DecreaseIdent(7)
+	}
+
+	if v.options.SelectColumns {
+		out.DecreaseIdent()
+		out.NewLine()
+		out.WriteString(")")
+	}
+
+	out.DecreaseIdent()
+	out.NewLine()
+	out.WriteString(")")
+}
+
+type valuesTableImpl struct {
+	selectTableImpl
+}
+
+// NewValuesTable creates new table source from the list of VALUES rows, with alias table name and
+// column names of the columns list. VALUES table without rows (models slice was empty) is valid statement
+// part, but statement execution returns an error, because SQL does not allow VALUES without rows.
+func NewValuesTable(options ValuesTableOptions, rows [][]Serializer, alias string, columns []ColumnExpression) SelectTable {
+	if len(columns) == 0 {
+		panic("jet: VALUES table requires at least one column")
+	}
+
+	for i, row := range rows {
+		if len(row) != len(columns) {
+			panic(fmt.Sprintf("jet: VALUES row %d has %d values, expected %d", i+1, len(row), len(columns)))
+		}
+	}
+
+	statement := &valuesStatement{
+		options: options,
+		rows:    rows,
+		columns: columns,
+	}
+
+	return valuesTableImpl{selectTableImpl: NewSelectTable(statement, alias)}
+}
+
+func (v valuesTableImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	v.Statement.serialize(statement, out)
+
+	out.WriteString("AS")
+	out.WriteIdentifier(v.alias)
+
+	if v.Statement.(*valuesStatement).options.SelectColumns {
+		return
+	}
+
+	out.WriteString("(")
+
+	for i, column := range v.Statement.(*valuesStatement).columns {
+		if i > 0 {
+			out.WriteString(", ")
+		}
+
+		out.WriteIdentifier(column.Name())
+	}
+
+	out.WriteByte(')')
+}
+
+// UnwindValuesRows returns VALUES table rows from the list of rows constructed with ROW or WRAP, followed by
+// the rows unwound from models slice (if not nil). Model struct fields are matched with columns by name.
+func UnwindValuesRows(rows []Expression, models interface{}, columns []ColumnExpression) [][]Serializer {
+	if len(rows) == 0 && models == nil {
+		panic("jet: VALUES table requires at least one row")
+	}
+
+	var ret [][]Serializer
+
+	for _, row := range rows {
+		var rowValues []Serializer
+
+		for _, value := range unwindValuesRow(row) {
+			rowValues = append(rowValues, value)
+		}
+
+		ret = append(ret, rowValues)
+	}
+
+	if models != nil {
+		var modelColumns []Column
+
+		for _, column := range columns {
+			modelColumns = append(modelColumns, column)
+		}
+
+		ret = append(ret, UnwindRowsFromModels("", modelColumns, models)...)
+	}
+
+	return ret
+}
+
+func unwindValuesRow(row Expression) []Expression {
+	switch r := row.(type) {
+	case *wrap:
+		return r.expressions
+	case *funcExpressionImpl:
+		if r.name == "ROW" || r.name == "" {
+			return r.parameters
+		}
+	}
+
+	panic("jet: VALUES row has to be constructed with ROW or WRAP")
+}
@@ -56,3 +56,53 @@ type ColumnTimestamp = jet.ColumnTimestamp
 
 // TimestampColumn creates named timestamp column
 var TimestampColumn = jet.TimestampColumn
+
+//------------------------------------------------------//
+
+// ColumnJson is interface of MySQL JSON columns.
+type ColumnJson interface {
+	JsonExpression
+	jet.Column
+
+	SET(jsonExp JsonExpression) ColumnAssigment
+
+	// EXTRACT returns JSON value at the path (column -> path)
+	EXTRACT(path string) JsonExpression
+	// EXTRACT_TEXT returns unquoted JSON value at the path (column ->> path)
+	EXTRACT_TEXT(path string) StringExpression
+
+	From(subQuery SelectTable) ColumnJson
+}
+
+type jsonColumnImpl struct {
+	jet.ColumnExpressionImpl
+	jsonInterfaceImpl
+}
+
+func (j *jsonColumnImpl) EXTRACT(path string) JsonExpression {
+	return JsonExp(jet.NewBinaryOperatorExpression(j, jet.FixedLiteral(path), "->"))
+}
+
+func (j *jsonColumnImpl) EXTRACT_TEXT(path string) StringExpression {
+	return StringExp(jet.NewBinaryOperatorExpression(j, jet.FixedLiteral(path), "->>"))
+}
+
+func (j *jsonColumnImpl) SET(jsonExp JsonExpression) ColumnAssigment {
+	return jet.NewColumnAssigment(j, jsonExp)
+}
+
+func (j *jsonColumnImpl) From(subQuery SelectTable) ColumnJson {
+	newJsonColumn := JsonColumn(j.Name())
+	jet.SetTableName(newJsonColumn, j.TableName())
+	jet.SetSubQuery(newJsonColumn, subQuery)
+
+	return newJsonColumn
+}
+
+// JsonColumn creates named json column.
+func JsonColumn(name string) ColumnJson {
+	jsonColumn := &jsonColumnImpl{}
+	jsonColumn.ColumnExpressionImpl = jet.NewColumnImpl(name, "", jsonColumn)
+	jsonColumn.jsonInterfaceImpl.parent = jsonColumn
+	return jsonColumn
+}
@@ -10,7 +10,9 @@ type DeleteStatement interface {
 
 	USING(tables ...ReadableTable) DeleteStatement
 	WHERE(expression BoolExpression) DeleteStatement
+	// ORDER_BY sets order of deleted rows. Panics if used with multiple-table DELETE.
 	ORDER_BY(orderByClauses ...OrderByClause) DeleteStatement
+	// LIMIT sets the maximum number of deleted rows. Panics if used with multiple-table DELETE.
 	LIMIT(limit int64) DeleteStatement
 }
 
@@ -24,7 +26,7 @@ type deleteStatementImpl struct {
 	Limit   jet.ClauseLimit
 }
 
-func newDeleteStatement(table Table) DeleteStatement {
+func newDeleteStatement(table jet.SerializerTable) DeleteStatement {
 	newDelete := &deleteStatementImpl{}
 	newDelete.SerializerStatement = jet.NewStatementImpl(Dialect, jet.DeleteStatementType, newDelete,
 		&newDelete.Delete,
@@ -58,11 +60,19 @@ func (d *deleteStatementImpl) WHERE(expression BoolExpression) DeleteStatement {
 }
 
 func (d *deleteStatementImpl) ORDER_BY(orderByClauses ...OrderByClause) DeleteStatement {
+	if len(d.Delete.Targets) > 0 {
+		panic("jet: ORDER BY can't be used with multiple-table DELETE")
+	}
+
 	d.OrderBy.List = orderByClauses
 	return d
 }
 
 func (d *deleteStatementImpl) LIMIT(limit int64) DeleteStatement {
+	if len(d.Delete.Targets) > 0 {
+		panic("jet: LIMIT can't be used with multiple-table DELETE")
+	}
+
 	d.Limit.Count = limit
 	return d
 }
@@ -2,6 +2,8 @@ package mysql
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestDeleteUnconditionally(t *testing.T) {
@@ -24,3 +26,45 @@ ORDER BY table1.col1
 LIMIT ?;
 `, int64(1), int64(1))
 }
+
+func TestDeleteJoinTable(t *testing.T) {
+	assertStatementSql(t, table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt)).
+		DELETE(table1).
+		WHERE(table2ColBool.IS_TRUE()), `
+DELETE db.table1 FROM db.table1
+INNER JOIN db.table2 ON (table1.col_int = table2.col_int)
+WHERE table2.col_bool IS TRUE;
+`)
+
+	t1ColInt := IntegerColumn("col_int")
+	t1 := NewTable("db", "table1", "t1", t1ColInt)
+
+	assertStatementSql(t, t1.INNER_JOIN(table2, t1ColInt.EQ(table2ColInt)).
+		DELETE(t1, table2).
+		WHERE(table2ColBool.IS_TRUE()), `
+DELETE t1, db.table2 FROM db.table1 AS t1
+INNER JOIN db.table2 ON (t1.col_int = table2.col_int)
+WHERE table2.col_bool IS TRUE;
+`)
+}
+
+func TestDeleteJoinTableInvalidTarget(t *testing.T) {
+	assertStatementSqlErr(t, table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt)).
+		DELETE(table3).
+		WHERE(table2ColBool.IS_TRUE()), "jet: table to delete from 'table3' does not appear in FROM clause")
+}
+
+func TestDeleteJoinTableOrderByLimit(t *testing.T) {
+	deleteStmt := func() DeleteStatement {
+		return table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt)).
+			DELETE(table1).
+			WHERE(table2ColBool.IS_TRUE())
+	}
+
+	require.PanicsWithValue(t, "jet: ORDER BY can't be used with multiple-table DELETE", func() {
+		deleteStmt().ORDER_BY(table1ColInt)
+	})
+	require.PanicsWithValue(t, "jet: LIMIT can't be used with multiple-table DELETE", func() {
+		deleteStmt().LIMIT(1)
+	})
+}
@@ -0,0 +1,270 @@
+package mysql
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// ExplainStatement is a statement showing the execution plan of explained statement
+type ExplainStatement = jet.ExplainStatement
+
+// QueryPlan is statement query plan, parsed from EXPLAIN statement output
+type QueryPlan = jet.QueryPlan
+
+// PlanNode is a node of the statement query plan tree
+type PlanNode = jet.PlanNode
+
+// ExplainOptions are EXPLAIN statement options
+type ExplainOptions struct {
+	// Analyze executes the statement and shows actual run times and row counts (EXPLAIN ANALYZE, MySQL 8.0.18+).
+	// Plan is returned in TREE format instead of JSON. Not supported by MariaDB.
+	Analyze bool
+}
+
+// EXPLAIN creates new statement showing the execution plan of the statement, using EXPLAIN FORMAT=JSON, or
+// EXPLAIN ANALYZE if Analyze option is set. Statement output can be parsed into query plan tree with
+// ExplainStatement QueryPlan method.
+//
+//	plan, err := EXPLAIN(stmt).QueryPlan(ctx, db)
+func EXPLAIN(statement Statement, options ...ExplainOptions) ExplainStatement {
+	if len(options) > 0 && options[0].Analyze {
+		return jet.NewExplainStatement(Dialect, "EXPLAIN ANALYZE", statement, parseTreeQueryPlan)
+	}
+
+	return jet.NewExplainStatement(Dialect, "EXPLAIN FORMAT=JSON", statement, parseJSONQueryPlan)
+}
+
+func scanExplainOutput(rows *sql.Rows) (string, error) {
+	if !rows.Next() {
+		return "", errors.New("EXPLAIN statement returned no rows")
+	}
+
+	var output string
+
+	err := rows.Scan(&output)
+
+	return output, err
+}
+
+func parseJSONQueryPlan(rows *sql.Rows) ([]PlanNode, error) {
+	output, err := scanExplainOutput(rows)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var plan map[string]interface{}
+
+	if err := json.Unmarshal([]byte(output), &plan); err != nil {
+		return nil, err
+	}
+
+	return jsonPlanNodes(plan), nil
+}
+
+// jsonPlanNodes converts each of the object properties, except cost info and scalar properties, into plan node
+func jsonPlanNodes(object map[string]interface{}) []PlanNode {
+	var keys []string
+
+	for key := range object {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	var ret []PlanNode
+
+	for _, key := range keys {
+		if key == "cost_info" {
+			continue
+		}
+
+		switch value := object[key].(type) {
+		case map[string]interface{}:
+			ret = append(ret, jsonPlanNode(key, value))
+		case []interface{}:
+			node := PlanNode{NodeType: key}
+
+			for _, element := range value {
+				if elementObject, ok := element.(map[string]interface{}); ok {
+					node.Children = append(node.Children, jsonPlanNodes(elementObject)...)
+				}
+			}
+
+			if len(node.Children) > 0 {
+				ret = append(ret, node)
+			}
+		}
+	}
+
+	return ret
+}
+
+func jsonPlanNode(name string, object map[string]interface{}) PlanNode {
+	node := PlanNode{
+		NodeType: name,
+		Children: jsonPlanNodes(object),
+	}
+
+	if name == "table" {
+		node.NodeType, _ = object["access_type"].(string)
+		node.Relation, _ = object["table_name"].(string)
+		node.Index, _ = object["key"].(string)
+		node.Detail, _ = object["attached_condition"].(string)
+		node.EstimatedRows = jsonFloat(object["rows_examined_per_scan"])
+	}
+
+	if costInfo, ok := object["cost_info"].(map[string]interface{}); ok {
+		if queryCost, ok := costInfo["query_cost"]; ok {
+			node.TotalCost = jsonFloat(queryCost)
+		} else {
+			node.TotalCost = jsonFloat(costInfo["prefix_cost"])
+		}
+	}
+
+	return node
+}
+
+// jsonFloat returns float value of the JSON number, or of the string containing number (cost info values)
+func jsonFloat(value interface{}) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case string:
+		ret, _ := strconv.ParseFloat(v, 64)
+		return ret
+	}
+
+	return 0
+}
+
+const treeNumber = `\d+(?:\.\d+)?(?:e[+-]?\d+)?`
+
+var (
+	treeCostRegex   = regexp.MustCompile(`\(cost=(` + treeNumber + `)(?:\.\.(` + treeNumber + `))? rows=(` + treeNumber + `)\)`)
+	treeActualRegex = regexp.MustCompile(`\(actual time=` + treeNumber + `\.\.` + treeNumber + ` rows=(` + treeNumber + `) loops=\d+\)`)
+)
+
+func parseTreeQueryPlan(rows *sql.Rows) ([]PlanNode, error) {
+	output, err := scanExplainOutput(rows)
+
+	if err != nil {
+		return nil, err
+	}
+
+	type treeNode struct {
+		depth    int
+		node     PlanNode
+		children []*treeNode
+	}
+
+	var roots []*treeNode
+	var stack []*treeNode
+
+	for _, line := range strings.Split(output, "\n") {
+		description := strings.TrimLeft(line, " ")
+
+		if !strings.HasPrefix(description, "->") {
+			continue
+		}
+
+		current := &treeNode{
+			depth: len(line) - len(description),
+			node:  treePlanNode(strings.TrimSpace(strings.TrimPrefix(description, "->"))),
+		}
+
+		for len(stack) > 0 && stack[len(stack)-1].depth >= current.depth {
+			stack = stack[:len(stack)-1]
+		}
+
+		if len(stack) == 0 {
+			roots = append(roots, current)
+		} else {
+			parent := stack[len(stack)-1]
+			parent.children = append(parent.children, current)
+		}
+
+		stack = append(stack, current)
+	}
+
+	if len(roots) == 0 {
+		return nil, errors.New("EXPLAIN ANALYZE output does not contain plan nodes")
+	}
+
+	var toPlanNode func(tree *treeNode) PlanNode
+
+	toPlanNode = func(tree *treeNode) PlanNode {
+		node := tree.node
+
+		for _, child := range tree.children {
+			node.Children = append(node.Children, toPlanNode(child))
+		}
+
+		return node
+	}
+
+	var ret []PlanNode
+
+	for _, root := range roots {
+		ret = append(ret, toPlanNode(root))
+	}
+
+	return ret, nil
+}
+
+// treePlanNode parses tree format plan node description, for instance:
+// Index lookup on f using idx_fk_language_id (language_id=l.language_id)  (cost=0.25 rows=10) (actual time=...)
+func treePlanNode(description string) PlanNode {
+	var node PlanNode
+
+	if match := treeCostRegex.FindStringSubmatch(description); match != nil {
+		if match[2] != "" {
+			node.StartupCost, _ = strconv.ParseFloat(match[1], 64)
+			node.TotalCost, _ = strconv.ParseFloat(match[2], 64)
+		} else {
+			node.TotalCost, _ = strconv.ParseFloat(match[1], 64)
+		}
+		node.EstimatedRows, _ = strconv.ParseFloat(match[3], 64)
+	}
+
+	if match := treeActualRegex.FindStringSubmatch(description); match != nil {
+		actualRows, _ := strconv.ParseFloat(match[1], 64)
+		node.ActualRows = &actualRows
+	}
+
+	description = treeActualRegex.ReplaceAllString(treeCostRegex.ReplaceAllString(description, ""), "")
+	description = strings.TrimSpace(description)
+
+	node.NodeType = description
+	node.Detail = description
+
+	colon, on := strings.Index(description, ": "), strings.Index(description, " on ")
+
+	if colon > 0 && (on < 0 || colon < on) {
+		node.NodeType = description[:colon]
+	} else if on > 0 {
+		node.NodeType = description[:on]
+
+		fields := strings.Fields(description[on+len(" on "):])
+
+		if len(fields) > 0 {
+			node.Relation = fields[0]
+		}
+
+		for i := 1; i+1 < len(fields); i++ {
+			if fields[i] == "using" {
+				node.Index = fields[i+1]
+				break
+			}
+		}
+	}
+
+	return node
+}
@@ -0,0 +1,143 @@
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-jet/jet/v2/qrm/qrmtest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplain(t *testing.T) {
+	stmt := SELECT(table1ColInt).FROM(table1).WHERE(table1ColInt.EQ(Int(1)))
+
+	assertStatementSql(t, EXPLAIN(stmt), `
+EXPLAIN FORMAT=JSON
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int = ?;
+`, int64(1))
+
+	assertStatementSql(t, EXPLAIN(stmt, ExplainOptions{Analyze: true}), `
+EXPLAIN ANALYZE
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int = ?;
+`, int64(1))
+}
+
+func TestExplainQueryPlanJSON(t *testing.T) {
+	stmt := EXPLAIN(SELECT(table1ColInt).FROM(table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))))
+
+	db := qrmtest.New()
+	db.ExpectStatement(stmt).WillReturnRows(qrmtest.NewRows("EXPLAIN").AddRow(`{
+  "query_block": {
+    "select_id": 1,
+    "cost_info": {
+      "query_cost": "14.50"
+    },
+    "nested_loop": [
+      {
+        "table": {
+          "table_name": "table1",
+          "access_type": "ALL",
+          "rows_examined_per_scan": 10,
+          "rows_produced_per_join": 10,
+          "cost_info": {
+            "read_cost": "1.00",
+            "eval_cost": "1.00",
+            "prefix_cost": "2.00"
+          },
+          "used_columns": ["col_int"],
+          "attached_condition": "(db.table1.col_int is not null)"
+        }
+      },
+      {
+        "table": {
+          "table_name": "table2",
+          "access_type": "ref",
+          "possible_keys": ["idx_col_int"],
+          "key": "idx_col_int",
+          "rows_examined_per_scan": 1,
+          "cost_info": {
+            "prefix_cost": "14.50"
+          }
+        }
+      }
+    ]
+  }
+}`))
+
+	plan, err := stmt.QueryPlan(context.Background(), db)
+	require.NoError(t, err)
+
+	require.Equal(t, []PlanNode{
+		{
+			NodeType:  "query_block",
+			TotalCost: 14.5,
+			Children: []PlanNode{
+				{
+					NodeType: "nested_loop",
+					Children: []PlanNode{
+						{
+							NodeType:      "ALL",
+							Relation:      "table1",
+							Detail:        "(db.table1.col_int is not null)",
+							EstimatedRows: 10,
+							TotalCost:     2,
+						},
+						{
+							NodeType:      "ref",
+							Relation:      "table2",
+							Index:         "idx_col_int",
+							EstimatedRows: 1,
+							TotalCost:     14.5,
+						},
+					},
+				},
+			},
+		},
+	}, plan.Nodes)
+	require.True(t, plan.UsesIndex("idx_col_int"))
+}
+
+func TestExplainQueryPlanTree(t *testing.T) {
+	stmt := EXPLAIN(
+		SELECT(table1ColInt).FROM(table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))),
+		ExplainOptions{Analyze: true},
+	)
+
+	db := qrmtest.New()
+	db.ExpectStatement(stmt).WillReturnRows(qrmtest.NewRows("EXPLAIN").AddRow(
+		"-> Nested loop inner join  (cost=14.50 rows=10) (actual time=0.050..0.070 rows=8 loops=1)\n" +
+			"    -> Filter: (table1.col_int is not null)  (cost=2.00 rows=10) (actual time=0.020..0.030 rows=10 loops=1)\n" +
+			"        -> Table scan on table1  (cost=2.00 rows=10) (actual time=0.019..0.025 rows=10 loops=1)\n" +
+			"    -> Covering index lookup on table2 using idx_col_int (col_int=table1.col_int)  (cost=0.25..1.20 rows=1) (actual time=0.002..0.003 rows=1 loops=10)\n",
+	))
+
+	plan, err := stmt.QueryPlan(context.Background(), db)
+	require.NoError(t, err)
+
+	require.Len(t, plan.Nodes, 1)
+	root := plan.Nodes[0]
+	require.Equal(t, "Nested loop inner join", root.NodeType)
+	require.Equal(t, 14.5, root.TotalCost)
+	require.Equal(t, float64(8), *root.ActualRows)
+	require.Len(t, root.Children, 2)
+
+	filter := root.Children[0]
+	require.Equal(t, "Filter", filter.NodeType)
+	require.Equal(t, "Filter: (table1.col_int is not null)", filter.Detail)
+	require.Len(t, filter.Children, 1)
+	require.Equal(t, "Table scan", filter.Children[0].NodeType)
+	require.Equal(t, "table1", filter.Children[0].Relation)
+
+	lookup := root.Children[1]
+	require.Equal(t, "Covering index lookup", lookup.NodeType)
+	require.Equal(t, "table2", lookup.Relation)
+	require.Equal(t, "idx_col_int", lookup.Index)
+	require.Equal(t, 0.25, lookup.StartupCost)
+	require.Equal(t, 1.2, lookup.TotalCost)
+	require.Equal(t, float64(1), lookup.EstimatedRows)
+	require.True(t, plan.UsesIndex("idx_col_int"))
+}
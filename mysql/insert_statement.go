@@ -1,6 +1,17 @@
 package mysql
 
-import "github.com/go-jet/jet/v2/internal/jet"
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// BeforeInsertHook can be implemented by model types to modify model before it is used by INSERT statement MODEL
+// and MODELS methods. Hook is called on statement execution, on a copy of the model taken by MODEL or MODELS,
+// and error returned from BeforeInsert is returned from the execution. Sql and DebugSql do not call the hook.
+type BeforeInsertHook = jet.BeforeInsertHook
 
 // InsertStatement is interface for SQL INSERT statements
 type InsertStatement interface {
@@ -17,8 +28,18 @@ type InsertStatement interface {
 	AS_NEW() InsertStatement
 
 	ON_DUPLICATE_KEY_UPDATE(assigments ...ColumnAssigment) InsertStatement
+	// ON_CONFLICT_PRIMARY_KEY handles rows conflicting on table primary key columns. If table does not have
+	// primary key columns, this method will panic.
+	ON_CONFLICT_PRIMARY_KEY() onConflictPrimaryKey
 
 	QUERY(selectStatement SelectStatement) InsertStatement
+
+	// ExecInBatches executes statement over db connection/transaction as a sequence of statements, each containing
+	// a subset of rows, so that no statement exceeds MySQL limit on the number of parametrized arguments.
+	// Returned result contains the total number of rows affected.
+	// Batches are executed as separate statements, so if db is not a transaction, rows inserted by the batches
+	// executed before a failing batch are not rolled back.
+	ExecInBatches(ctx context.Context, db qrm.Executable) (sql.Result, error)
 }
 
 func newInsertStatement(table Table, columns []jet.Column) InsertStatement {
@@ -54,12 +75,12 @@ func (is *insertStatementImpl) VALUES(value interface{}, values ...interface{})
 }
 
 func (is *insertStatementImpl) MODEL(data interface{}) InsertStatement {
-	is.ValuesQuery.Rows = append(is.ValuesQuery.Rows, jet.UnwindRowFromModel(is.Insert.GetColumns(), data))
+	is.ValuesQuery.Rows = append(is.ValuesQuery.Rows, jet.UnwindRowFromModel(jet.InsertStatementType, is.Insert.GetColumns(), data))
 	return is
 }
 
 func (is *insertStatementImpl) MODELS(data interface{}) InsertStatement {
-	is.ValuesQuery.Rows = append(is.ValuesQuery.Rows, jet.UnwindRowsFromModels(is.Insert.GetColumns(), data)...)
+	is.ValuesQuery.Rows = append(is.ValuesQuery.Rows, jet.UnwindRowsFromModels(jet.InsertStatementType, is.Insert.GetColumns(), data)...)
 	return is
 }
 
@@ -69,27 +90,87 @@ func (is *insertStatementImpl) AS_NEW() InsertStatement {
 }
 
 func (is *insertStatementImpl) ON_DUPLICATE_KEY_UPDATE(assigments ...ColumnAssigment) InsertStatement {
-	is.OnDuplicateKey = assigments
+	is.OnDuplicateKey = onDuplicateKeyUpdateClause{Assigments: assigments}
 	return is
 }
 
+func (is *insertStatementImpl) ON_CONFLICT_PRIMARY_KEY() onConflictPrimaryKey {
+	primaryKeys := jet.PrimaryKeys(is.Insert.Table)
+
+	if len(primaryKeys) == 0 {
+		panic("jet: ON_CONFLICT_PRIMARY_KEY requires table with primary key columns")
+	}
+
+	return &onConflictPrimaryKeyImpl{
+		insertStatement: is,
+		primaryKeys:     primaryKeys,
+	}
+}
+
 func (is *insertStatementImpl) QUERY(selectStatement SelectStatement) InsertStatement {
 	is.ValuesQuery.Query = selectStatement
 	return is
 }
 
-type onDuplicateKeyUpdateClause []jet.ColumnAssigment
+// MySQL limits the number of placeholders in prepared statement to 65535.
+const maxArgumentsPerStatement = 65535
+
+func (is *insertStatementImpl) ExecInBatches(ctx context.Context, db qrm.Executable) (sql.Result, error) {
+	return jet.ExecInBatches(ctx, db, Dialect, maxArgumentsPerStatement, is.ValuesQuery.Rows, is.batchStatement)
+}
+
+func (is *insertStatementImpl) batchStatement(rows [][]jet.Serializer) Statement {
+	batch := newInsertStatement(nil, nil).(*insertStatementImpl)
+	batch.Insert = is.Insert
+	batch.ValuesQuery = is.ValuesQuery
+	batch.OnDuplicateKey = is.OnDuplicateKey
+	batch.ValuesQuery.Rows = rows
+
+	return batch
+}
+
+type onDuplicateKeyUpdateClause struct {
+	Assigments []jet.ColumnAssigment
+
+	// UpdateAll is set by DO_UPDATE_ALL. Update all assignments are created on serialization, so that inserted
+	// row alias set with AS_NEW is used regardless of the order the methods are called in.
+	UpdateAll *updateAllAssigments
+}
+
+type updateAllAssigments struct {
+	columns         []jet.Column
+	excludedColumns []jet.Column
+	valuesQuery     *jet.ClauseValuesQuery
+}
+
+func (u *updateAllAssigments) assigments() []jet.ColumnAssigment {
+	newRowAlias := u.valuesQuery.As
+
+	return jet.NewColumnAssigments(u.columns, u.excludedColumns, func(column jet.Column) jet.Expression {
+		if newRowAlias != "" {
+			return jet.NewTableColumn(newRowAlias, column)
+		}
+
+		return jet.NewFunc("VALUES", []jet.Expression{jet.NewTableColumn("", column)}, nil)
+	})
+}
 
 // Serialize for SetClause
 func (s onDuplicateKeyUpdateClause) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
-	if len(s) == 0 {
+	assigments := s.Assigments
+
+	if s.UpdateAll != nil {
+		assigments = s.UpdateAll.assigments()
+	}
+
+	if len(assigments) == 0 {
 		return
 	}
 	out.NewLine()
 	out.WriteString("ON DUPLICATE KEY UPDATE")
 	out.IncreaseIdent(24)
 
-	for i, assigment := range s {
+	for i, assigment := range assigments {
 		if i > 0 {
 			out.WriteString(",")
 			out.NewLine()
@@ -100,3 +181,46 @@ func (s onDuplicateKeyUpdateClause) Serialize(statementType jet.StatementType, o
 
 	out.DecreaseIdent(24)
 }
+
+type onConflictPrimaryKey interface {
+	// DO_NOTHING leaves conflicting rows unchanged, by assigning primary key columns to themselves.
+	DO_NOTHING() InsertStatement
+	// DO_UPDATE_ALL updates each inserted column, except primary key columns, with the value of the
+	// row proposed for insertion. If inserted row alias is set with AS_NEW, values are referenced through the alias
+	// (new.column), otherwise with VALUES(column) function, deprecated since MySQL 8.0.20.
+	DO_UPDATE_ALL() InsertStatement
+}
+
+type onConflictPrimaryKeyImpl struct {
+	insertStatement *insertStatementImpl
+	primaryKeys     []jet.ColumnExpression
+}
+
+func (o *onConflictPrimaryKeyImpl) DO_NOTHING() InsertStatement {
+	return o.insertStatement.ON_DUPLICATE_KEY_UPDATE(jet.NewColumnAssigments(o.primaryKeyColumns(), nil,
+		func(column jet.Column) jet.Expression {
+			return jet.NewTableColumn("", column)
+		})...)
+}
+
+func (o *onConflictPrimaryKeyImpl) DO_UPDATE_ALL() InsertStatement {
+	o.insertStatement.OnDuplicateKey = onDuplicateKeyUpdateClause{
+		UpdateAll: &updateAllAssigments{
+			columns:         o.insertStatement.Insert.GetColumns(),
+			excludedColumns: o.primaryKeyColumns(),
+			valuesQuery:     &o.insertStatement.ValuesQuery,
+		},
+	}
+
+	return o.insertStatement
+}
+
+func (o *onConflictPrimaryKeyImpl) primaryKeyColumns() []jet.Column {
+	var ret []jet.Column
+
+	for _, primaryKey := range o.primaryKeys {
+		ret = append(ret, primaryKey)
+	}
+
+	return ret
+}
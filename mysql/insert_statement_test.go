@@ -1,6 +1,7 @@
 package mysql
 
 import (
+	"context"
 	"github.com/stretchr/testify/require"
 	"testing"
 	"time"
@@ -179,3 +180,135 @@ ON DUPLICATE KEY UPDATE col_bool = ?,
 `, "two", true, int64(11), 11.1, "str", "11:23:11", "2020-01-22 03:04:05", "2020-12-01")
 	})
 }
+
+func TestInsert_ON_CONFLICT_PRIMARY_KEY(t *testing.T) {
+	stmt := func() InsertStatement {
+		return table1WithPK.INSERT(table1Col1, table1ColInt, table1ColFloat).
+			VALUES(1, 2, 3.3)
+	}
+
+	t.Run("do update all", func(t *testing.T) {
+		assertStatementSql(t, stmt().ON_CONFLICT_PRIMARY_KEY().DO_UPDATE_ALL(), `
+INSERT INTO db.table1 (col1, col_int, col_float)
+VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE col_int = VALUES(col_int),
+                        col_float = VALUES(col_float);
+`, 1, 2, 3.3)
+	})
+
+	t.Run("do update all as new", func(t *testing.T) {
+		assertStatementSql(t, stmt().AS_NEW().ON_CONFLICT_PRIMARY_KEY().DO_UPDATE_ALL(), `
+INSERT INTO db.table1 (col1, col_int, col_float)
+VALUES (?, ?, ?) AS new
+ON DUPLICATE KEY UPDATE col_int = new.col_int,
+                        col_float = new.col_float;
+`, 1, 2, 3.3)
+	})
+
+	t.Run("do update all before as new", func(t *testing.T) {
+		assertStatementSql(t, stmt().ON_CONFLICT_PRIMARY_KEY().DO_UPDATE_ALL().AS_NEW(), `
+INSERT INTO db.table1 (col1, col_int, col_float)
+VALUES (?, ?, ?) AS new
+ON DUPLICATE KEY UPDATE col_int = new.col_int,
+                        col_float = new.col_float;
+`, 1, 2, 3.3)
+	})
+
+	t.Run("do nothing", func(t *testing.T) {
+		assertStatementSql(t, stmt().ON_CONFLICT_PRIMARY_KEY().DO_NOTHING(), `
+INSERT INTO db.table1 (col1, col_int, col_float)
+VALUES (?, ?, ?)
+ON DUPLICATE KEY UPDATE col1 = col1;
+`, 1, 2, 3.3)
+	})
+
+	require.PanicsWithValue(t, "jet: ON_CONFLICT_PRIMARY_KEY requires table with primary key columns", func() {
+		table1.INSERT(table1ColInt).VALUES(1).ON_CONFLICT_PRIMARY_KEY()
+	})
+}
+
+func TestInsertIgnore(t *testing.T) {
+	assertStatementSql(t, table1.INSERT_IGNORE(table1Col1, table1ColFloat).VALUES(1, 2.2).VALUES(3, 4.4), `
+INSERT IGNORE INTO db.table1 (col1, col_float)
+VALUES (?, ?),
+       (?, ?);
+`, 1, 2.2, 3, 4.4)
+
+	assertStatementSql(t, table1.INSERT_IGNORE(table1Col1).
+		OPTIMIZER_HINTS("NO_ICP(table1)").
+		QUERY(SELECT(table2ColInt).FROM(table2)), `
+INSERT /*+ NO_ICP(table1) */ IGNORE INTO db.table1 (col1) (
+     SELECT table2.col_int AS "table2.col_int"
+     FROM db.table2
+);
+`)
+}
+
+func TestReplace(t *testing.T) {
+	assertStatementSql(t, table1.REPLACE(table1Col1, table1ColFloat).VALUES(1, 2.2), `
+REPLACE INTO db.table1 (col1, col_float)
+VALUES (?, ?);
+`, 1, 2.2)
+
+	type Table1Model struct {
+		Col1     *int
+		ColFloat float64
+	}
+
+	one := 1
+
+	assertStatementSql(t, table1.REPLACE(table1Col1, table1ColFloat).
+		MODEL(Table1Model{Col1: &one, ColFloat: 1.1}).
+		MODELS([]Table1Model{{ColFloat: 2.2}}), `
+REPLACE INTO db.table1 (col1, col_float)
+VALUES (?, ?),
+       (?, ?);
+`, int(1), 1.1, nil, 2.2)
+
+	assertStatementSql(t, table1.REPLACE(table1Col1).
+		QUERY(SELECT(table2ColInt).FROM(table2)), `
+REPLACE INTO db.table1 (col1) (
+     SELECT table2.col_int AS "table2.col_int"
+     FROM db.table2
+);
+`)
+}
+
+func TestInsert_ExecInBatches(t *testing.T) {
+	type Table1Model struct {
+		Col1     *int
+		ColFloat *float64
+		ColBool  bool
+	}
+
+	var models []Table1Model
+
+	for i := 0; i < 30000; i++ {
+		models = append(models, Table1Model{ColBool: i%2 == 0})
+	}
+
+	stmt := table1.INSERT(table1Col1, table1ColFloat, table1ColBool).
+		MODELS(models).
+		ON_DUPLICATE_KEY_UPDATE(table1ColBool.SET(Bool(true)))
+
+	db := &execRecorder{}
+
+	res, err := stmt.ExecInBatches(context.Background(), db)
+	require.NoError(t, err)
+
+	rowsAffected, err := res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(30000), rowsAffected)
+
+	// ON DUPLICATE KEY UPDATE argument is repeated in each of the batches
+	require.Len(t, db.queries, 2)
+	require.Len(t, db.args[0], 1+21844*3)
+	require.Len(t, db.args[1], 1+(30000-21844)*3)
+	require.Contains(t, db.queries[0], "ON DUPLICATE KEY UPDATE col_bool = ?;")
+	require.Contains(t, db.queries[1], "VALUES (?, ?, ?),")
+	require.Contains(t, db.queries[1], "ON DUPLICATE KEY UPDATE col_bool = ?;")
+
+	// original statement is not modified
+	_, args := stmt.Sql()
+	require.Len(t, args, 90001)
+}
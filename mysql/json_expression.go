@@ -0,0 +1,141 @@
+package mysql
+
+import (
+	"encoding/json"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// JsonExpression is representation of MySQL JSON document
+type JsonExpression interface {
+	jet.Expression
+
+	isJson()
+
+	EQ(rhs JsonExpression) BoolExpression
+	NOT_EQ(rhs JsonExpression) BoolExpression
+
+	// CONTAINS returns true if candidate JSON document is contained within the document, optionally at the path
+	// (JSON_CONTAINS(document, candidate[, path]))
+	CONTAINS(candidate JsonExpression, path ...string) BoolExpression
+}
+
+type jsonInterfaceImpl struct {
+	parent JsonExpression
+}
+
+func (j *jsonInterfaceImpl) isJson() {}
+
+func (j *jsonInterfaceImpl) EQ(rhs JsonExpression) BoolExpression {
+	return jet.Eq(j.parent, rhs)
+}
+
+func (j *jsonInterfaceImpl) NOT_EQ(rhs JsonExpression) BoolExpression {
+	return jet.NotEq(j.parent, rhs)
+}
+
+func (j *jsonInterfaceImpl) CONTAINS(candidate JsonExpression, path ...string) BoolExpression {
+	return JSON_CONTAINS(j.parent, candidate, path...)
+}
+
+type jsonWrapper struct {
+	jsonInterfaceImpl
+	Expression
+}
+
+// JsonExp is json expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as json expression.
+// Does not add sql cast to generated sql builder output.
+func JsonExp(expression Expression) JsonExpression {
+	jsonWrap := &jsonWrapper{Expression: expression}
+	jsonWrap.jsonInterfaceImpl.parent = jsonWrap
+	return jsonWrap
+}
+
+// Json creates new json literal expression. String and []byte values are passed as JSON text, other
+// values are marshaled into JSON.
+//
+//	Json(`{"rating": "PG"}`)
+//	Json(map[string]interface{}{"rating": "PG"})
+func Json(value interface{}) JsonExpression {
+	switch v := value.(type) {
+	case string:
+	case []byte:
+		value = string(v)
+	default:
+		jsonValue, err := json.Marshal(value)
+
+		if err != nil {
+			panic("jet: failed to marshal json literal: " + err.Error())
+		}
+
+		value = string(jsonValue)
+	}
+
+	return JsonExp(CAST(jet.Literal(value)).AS("JSON"))
+}
+
+//------------------ JSON functions ------------------//
+
+// JSON_EXTRACT returns data from a JSON document, selected from the parts of the document matched by the path
+// arguments. If more than one path is given, matched values are wrapped into JSON array.
+func JSON_EXTRACT(document JsonExpression, path string, paths ...string) JsonExpression {
+	return JsonExp(jet.Func("JSON_EXTRACT", append([]Expression{document}, jsonPaths(path, paths)...)...))
+}
+
+// JSON_UNQUOTE unquotes JSON value and returns the result as a string
+//
+//	JSON_UNQUOTE(JSON_EXTRACT(Film.Info, "$.rating"))
+func JSON_UNQUOTE(value JsonExpression) StringExpression {
+	return StringExp(jet.Func("JSON_UNQUOTE", value))
+}
+
+// JSON_CONTAINS returns true if candidate JSON document is contained within the target document, or within
+// the target document at the path if path is given.
+func JSON_CONTAINS(target, candidate JsonExpression, path ...string) BoolExpression {
+	args := []Expression{target, candidate}
+
+	if len(path) > 0 {
+		args = append(args, String(path[0]))
+	}
+
+	return BoolExp(jet.Func("JSON_CONTAINS", args...))
+}
+
+// JSON_SET inserts or updates the value in a JSON document at the path, and returns the result. To set
+// values at more than one path, JSON_SET calls can be nested.
+//
+//	JSON_SET(Film.Info, "$.rating", String("PG"))
+func JSON_SET(document JsonExpression, path string, value Expression) JsonExpression {
+	return JsonExp(jet.Func("JSON_SET", document, String(path), value))
+}
+
+// JSON_ARRAYAGG is aggregate function returning JSON array containing the values of expression across all
+// the input rows
+func JSON_ARRAYAGG(expression Expression) JsonExpression {
+	return JsonExp(jet.Func("JSON_ARRAYAGG", expression))
+}
+
+// JSON_OBJECTAGG is aggregate function returning JSON object containing key-value pairs constructed from
+// key and value expressions across all the input rows
+func JSON_OBJECTAGG(key StringExpression, value Expression) JsonExpression {
+	return JsonExp(jet.Func("JSON_OBJECTAGG", key, value))
+}
+
+// MEMBER_OF returns true if value is an element of JSON array (value MEMBER OF(json_array)).
+// Requires MySQL 8.0.17 or later.
+//
+//	MEMBER_OF(String("action"), Film.Tags)
+func MEMBER_OF(value Expression, jsonArray JsonExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(value, jet.WRAP(jsonArray), "MEMBER OF"))
+}
+
+func jsonPaths(path string, paths []string) []Expression {
+	ret := []Expression{String(path)}
+
+	for _, p := range paths {
+		ret = append(ret, String(p))
+	}
+
+	return ret
+}
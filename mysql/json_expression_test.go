@@ -0,0 +1,57 @@
+package mysql
+
+import "testing"
+
+var tableJsonCol = JsonColumn("col_json")
+var tableJson = NewTable("db", "table_json", "", IntegerColumn("id"), tableJsonCol)
+
+func TestJsonColumnOperators(t *testing.T) {
+	assertSerialize(t, tableJsonCol.EXTRACT("$.rating"), "(table_json.col_json -> '$.rating')")
+	assertSerialize(t, tableJsonCol.EXTRACT_TEXT("$.rating"), "(table_json.col_json ->> '$.rating')")
+	assertSerialize(t, tableJsonCol.EXTRACT_TEXT("$.rating").EQ(String("PG")),
+		"((table_json.col_json ->> '$.rating') = ?)", "PG")
+	assertSerialize(t, tableJsonCol.EQ(Json(`{"a": 1}`)), "(table_json.col_json = CAST(? AS JSON))", `{"a": 1}`)
+	assertSerialize(t, tableJsonCol.CONTAINS(Json([]int{1, 2}), "$.ids"),
+		"JSON_CONTAINS(table_json.col_json, CAST(? AS JSON), ?)", "[1,2]", "$.ids")
+}
+
+func TestJsonLiteral(t *testing.T) {
+	assertDebugSerialize(t, Json(`{"rating": "PG"}`), `CAST('{"rating": "PG"}' AS JSON)`)
+	assertDebugSerialize(t, Json([]byte(`[1]`)), `CAST('[1]' AS JSON)`)
+	assertDebugSerialize(t, Json(map[string]interface{}{"rating": "PG"}), `CAST('{"rating":"PG"}' AS JSON)`)
+}
+
+func TestJsonFunctions(t *testing.T) {
+	assertSerialize(t, JSON_EXTRACT(tableJsonCol, "$.rating"), "JSON_EXTRACT(table_json.col_json, ?)", "$.rating")
+	assertSerialize(t, JSON_EXTRACT(tableJsonCol, "$.a", "$.b"), "JSON_EXTRACT(table_json.col_json, ?, ?)", "$.a", "$.b")
+	assertSerialize(t, JSON_UNQUOTE(JSON_EXTRACT(tableJsonCol, "$.rating")),
+		"JSON_UNQUOTE(JSON_EXTRACT(table_json.col_json, ?))", "$.rating")
+	assertSerialize(t, JSON_CONTAINS(tableJsonCol, Json(`"PG"`)),
+		"JSON_CONTAINS(table_json.col_json, CAST(? AS JSON))", `"PG"`)
+	assertSerialize(t, JSON_SET(tableJsonCol, "$.rating", String("PG")),
+		"JSON_SET(table_json.col_json, ?, ?)", "$.rating", "PG")
+	assertSerialize(t, JSON_ARRAYAGG(table1ColInt), "JSON_ARRAYAGG(table1.col_int)")
+	assertSerialize(t, JSON_OBJECTAGG(table1ColString, table1ColInt), "JSON_OBJECTAGG(table1.col_string, table1.col_int)")
+	assertSerialize(t, MEMBER_OF(Int(3), tableJsonCol.EXTRACT("$.ids")),
+		"(? MEMBER OF (table_json.col_json -> '$.ids'))", int64(3))
+	assertSerialize(t, MEMBER_OF(String("action"), tableJsonCol),
+		"(? MEMBER OF (table_json.col_json))", "action")
+}
+
+func TestJsonColumnFrom(t *testing.T) {
+	subQuery := SELECT(tableJsonCol).FROM(tableJson).AsTable("sub")
+
+	assertSerialize(t, tableJsonCol.From(subQuery).EXTRACT("$.a"), "(sub.`table_json.col_json` -> '$.a')")
+}
+
+func TestJsonColumnSet(t *testing.T) {
+	stmt := tableJson.UPDATE().
+		SET(tableJsonCol.SET(JSON_SET(tableJsonCol, "$.a", Int(1)))).
+		WHERE(tableJsonCol.IS_NOT_NULL())
+
+	assertStatementSql(t, stmt, `
+UPDATE db.table_json
+SET col_json = JSON_SET(table_json.col_json, ?, ?)
+WHERE table_json.col_json IS NOT NULL;
+`, "$.a", int64(1))
+}
@@ -0,0 +1,68 @@
+package mysql
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// JsonTableColumn is column definition of JSON_TABLE table function
+type JsonTableColumn = jet.JsonTableColumn
+
+// JsonTablePathColumn is JSON_TABLE column with value extracted from the JSON path
+type JsonTablePathColumn = jet.JsonTablePathColumn
+
+type jsonTable struct {
+	document JsonExpression
+	path     string
+	columns  []JsonTableColumn
+}
+
+// JSON_TABLE creates table source from JSON document, with a row for each value matched by the path, and
+// a column for each of the columns definitions. Table alias is set with AS method, and table columns can be
+// referenced with column From method. Requires MySQL 8.0.4 or later.
+//
+//	orderID, amount := IntegerColumn("order_id"), FloatColumn("amount")
+//	orders := JSON_TABLE(Customer.Info, "$.orders[*]",
+//		PATH(orderID, "INT", "$.id"),
+//		PATH(amount, "DECIMAL(10,2)", "$.amount").DEFAULT_ON_EMPTY("0"),
+//	).AS("orders")
+//
+//	SELECT(Customer.CustomerID, orderID.From(orders), amount.From(orders)).
+//		FROM(Customer.CROSS_JOIN(orders))
+func JSON_TABLE(document JsonExpression, path string, columns ...JsonTableColumn) jsonTable {
+	return jsonTable{
+		document: document,
+		path:     path,
+		columns:  columns,
+	}
+}
+
+// AS sets JSON_TABLE alias name
+func (j jsonTable) AS(alias string) SelectTable {
+	table := &selectTableImpl{
+		SelectTable: jet.NewJsonTable(j.document, j.path, j.columns, alias),
+	}
+
+	table.readableTableInterfaceImpl.parent = table
+
+	return table
+}
+
+// FOR_ORDINALITY creates JSON_TABLE column enumerating the rows, starting from 1 (column FOR ORDINALITY)
+func FOR_ORDINALITY(column ColumnInteger) JsonTableColumn {
+	return jet.NewJsonTableOrdinalityColumn(column)
+}
+
+// PATH creates JSON_TABLE column of sqlType type, with value extracted from the JSON path (column type PATH path)
+func PATH(column Column, sqlType string, path string) JsonTablePathColumn {
+	return jet.NewJsonTablePathColumn(column, sqlType, path, false)
+}
+
+// EXISTS_PATH creates JSON_TABLE column of sqlType type, with value 1 if the JSON path matches any value,
+// and 0 otherwise (column type EXISTS PATH path)
+func EXISTS_PATH(column Column, sqlType string, path string) JsonTableColumn {
+	return jet.NewJsonTablePathColumn(column, sqlType, path, true)
+}
+
+// NESTED_PATH creates JSON_TABLE nested columns, producing a row for each value matched by the path inside
+// the parent row value (NESTED PATH path COLUMNS (...))
+func NESTED_PATH(path string, columns ...JsonTableColumn) JsonTableColumn {
+	return jet.NewJsonTableNestedColumns(path, columns)
+}
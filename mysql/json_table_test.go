@@ -0,0 +1,70 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/go-jet/jet/v2/internal/testutils"
+)
+
+func TestJsonTable(t *testing.T) {
+	rowID, orderID, amount, hasNote := IntegerColumn("row_id"), IntegerColumn("order_id"), FloatColumn("amount"), BoolColumn("has_note")
+	itemName := StringColumn("item_name")
+
+	orders := JSON_TABLE(tableJsonCol, "$.orders[*]",
+		FOR_ORDINALITY(rowID),
+		PATH(orderID, "INT", "$.id").ERROR_ON_ERROR(),
+		PATH(amount, "DECIMAL(10,2)", "$.amount").DEFAULT_ON_EMPTY("0"),
+		EXISTS_PATH(hasNote, "BOOLEAN", "$.note"),
+		NESTED_PATH("$.items[*]",
+			PATH(itemName, "VARCHAR(100)", "$.name"),
+		),
+	).AS("orders")
+
+	stmt := SELECT(
+		orderID.From(orders), amount.From(orders), itemName.From(orders),
+	).FROM(
+		tableJson.CROSS_JOIN(orders),
+	).WHERE(
+		hasNote.From(orders).IS_TRUE(),
+	)
+
+	testutils.AssertDebugStatementSql(t, stmt, `
+SELECT orders.order_id AS "order_id",
+     orders.amount AS "amount",
+     orders.item_name AS "item_name"
+FROM db.table_json
+     CROSS JOIN JSON_TABLE(table_json.col_json, '$.orders[*]' COLUMNS (
+          row_id FOR ORDINALITY,
+          order_id INT PATH '$.id' ERROR ON ERROR,
+          amount DECIMAL(10,2) PATH '$.amount' DEFAULT '0' ON EMPTY,
+          has_note BOOLEAN EXISTS PATH '$.note',
+          NESTED PATH '$.items[*]' COLUMNS (
+               item_name VARCHAR(100) PATH '$.name'
+          )
+     )) AS orders
+WHERE orders.has_note IS TRUE;
+`)
+}
+
+func TestJsonTableAllColumns(t *testing.T) {
+	id := IntegerColumn("id")
+
+	ids := JSON_TABLE(Json("[1, 2]"), "$[*]", PATH(id, "INT", "$")).AS("ids")
+
+	assertStatementSql(t, SELECT(ids.AllColumns()).FROM(ids), `
+SELECT ids.id AS "id"
+FROM JSON_TABLE(CAST(? AS JSON), '$[*]' COLUMNS (
+          id INT PATH '$'
+     )) AS ids;
+`, "[1, 2]")
+}
+
+func TestJsonTableInvalid(t *testing.T) {
+	assertPanicErr(t, func() {
+		JSON_TABLE(tableJsonCol, "$[*]").AS("empty")
+	}, "jet: JSON_TABLE requires at least one column")
+
+	assertPanicErr(t, func() {
+		NESTED_PATH("$[*]")
+	}, "jet: JSON_TABLE NESTED PATH requires at least one column")
+}
@@ -0,0 +1,93 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// ReplaceStatement is interface for MySQL REPLACE statement. REPLACE works exactly like INSERT, except that
+// if an old row has the same value as a new row for a primary key or unique index, the old row is deleted
+// before the new row is inserted.
+type ReplaceStatement interface {
+	Statement
+
+	OPTIMIZER_HINTS(hints ...OptimizerHint) ReplaceStatement
+
+	// Replace row of values
+	VALUES(value interface{}, values ...interface{}) ReplaceStatement
+	// Replace row of values, where value for each column is extracted from filed of structure data.
+	// If data is not struct or there is no field for every column selected, this method will panic.
+	MODEL(data interface{}) ReplaceStatement
+	MODELS(data interface{}) ReplaceStatement
+
+	QUERY(selectStatement SelectStatement) ReplaceStatement
+
+	// ExecInBatches executes statement over db connection/transaction as a sequence of statements, each containing
+	// a subset of rows, so that no statement exceeds MySQL limit on the number of parametrized arguments.
+	// Returned result contains the total number of rows affected.
+	// Batches are executed as separate statements, so if db is not a transaction, rows inserted by the batches
+	// executed before a failing batch are not rolled back.
+	ExecInBatches(ctx context.Context, db qrm.Executable) (sql.Result, error)
+}
+
+func newReplaceStatement(table Table, columns []jet.Column) ReplaceStatement {
+	newReplace := &replaceStatementImpl{}
+	newReplace.SerializerStatement = jet.NewStatementImpl(Dialect, jet.InsertStatementType, newReplace,
+		&newReplace.Replace,
+		&newReplace.ValuesQuery,
+	)
+
+	newReplace.Replace.Table = table
+	newReplace.Replace.Columns = columns
+	newReplace.Replace.Replace = true
+
+	return newReplace
+}
+
+type replaceStatementImpl struct {
+	jet.SerializerStatement
+
+	Replace     jet.ClauseInsert
+	ValuesQuery jet.ClauseValuesQuery
+}
+
+func (rs *replaceStatementImpl) OPTIMIZER_HINTS(hints ...OptimizerHint) ReplaceStatement {
+	rs.Replace.OptimizerHints = hints
+	return rs
+}
+
+func (rs *replaceStatementImpl) VALUES(value interface{}, values ...interface{}) ReplaceStatement {
+	rs.ValuesQuery.Rows = append(rs.ValuesQuery.Rows, jet.UnwindRowFromValues(value, values))
+	return rs
+}
+
+func (rs *replaceStatementImpl) MODEL(data interface{}) ReplaceStatement {
+	rs.ValuesQuery.Rows = append(rs.ValuesQuery.Rows, jet.UnwindRowFromModel(jet.InsertStatementType, rs.Replace.GetColumns(), data))
+	return rs
+}
+
+func (rs *replaceStatementImpl) MODELS(data interface{}) ReplaceStatement {
+	rs.ValuesQuery.Rows = append(rs.ValuesQuery.Rows, jet.UnwindRowsFromModels(jet.InsertStatementType, rs.Replace.GetColumns(), data)...)
+	return rs
+}
+
+func (rs *replaceStatementImpl) QUERY(selectStatement SelectStatement) ReplaceStatement {
+	rs.ValuesQuery.Query = selectStatement
+	return rs
+}
+
+func (rs *replaceStatementImpl) ExecInBatches(ctx context.Context, db qrm.Executable) (sql.Result, error) {
+	return jet.ExecInBatches(ctx, db, Dialect, maxArgumentsPerStatement, rs.ValuesQuery.Rows, rs.batchStatement)
+}
+
+func (rs *replaceStatementImpl) batchStatement(rows [][]jet.Serializer) Statement {
+	batch := newReplaceStatement(nil, nil).(*replaceStatementImpl)
+	batch.Replace = rs.Replace
+	batch.ValuesQuery = rs.ValuesQuery
+	batch.ValuesQuery.Rows = rows
+
+	return batch
+}
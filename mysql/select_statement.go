@@ -1,7 +1,10 @@
 package mysql
 
 import (
+	"context"
+
 	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
 )
 
 // RowLock is interface for SELECT statement row lock types
@@ -58,6 +61,22 @@ type SelectStatement interface {
 	UNION_ALL(rhs SelectStatement) setStatement
 
 	AsTable(alias string) SelectTable
+
+	// CountStatement returns new statement that counts the number of rows this statement returns,
+	// with ORDER BY, LIMIT, OFFSET and row lock clauses removed.
+	CountStatement() Statement
+	// QueryWithCount executes statement and stores row results in destination. Total number of rows,
+	// without LIMIT and OFFSET applied, is retrieved with CountStatement.
+	// Data and count queries are executed separately, so for a consistent result db should be a transaction with
+	// REPEATABLE READ (InnoDB default) or SERIALIZABLE isolation level.
+	QueryWithCount(ctx context.Context, db qrm.Queryable, destination interface{}) (totalCount int64, err error)
+	// JSONNestedStatement returns new statement, where projections mapped into destination fields of slice or map
+	// of structs type are aggregated into JSON arrays with correlated sub-queries, instead of being joined into the
+	// result set. Tables of the nested projections are moved from the FROM clause into the sub-queries, and ON condition
+	// of the first moved table becomes sub-query WHERE condition, so rows without nested rows are not filtered out.
+	// LIMIT and OFFSET of the new statement apply to the destination rows only. Statement has to be queried into
+	// destination of the same type.
+	JSONNestedStatement(destination interface{}) Statement
 }
 
 // SELECT creates new SelectStatement with list of projections
@@ -65,6 +84,22 @@ func SELECT(projection Projection, projections ...Projection) SelectStatement {
 	return newSelectStatement(nil, append([]Projection{projection}, projections...))
 }
 
+// SELECT_JSON_ARR creates new SelectStatement that aggregates all the selected rows into a single JSON array of
+// objects. Object keys are projection aliases, so the statement can be used as a nested projection (for instance
+// SELECT_JSON_ARR(Actor.AllColumns).FROM(Actor).WHERE(...).AS("actors")), and query result mapping will decode
+// the JSON array into destination slice field using the same alias conventions as for the result set columns.
+// MySQL JSON_ARRAYAGG does not preserve the order of aggregated rows, so the order of JSON array elements is not
+// guaranteed. ORDER BY clause is serialized only together with LIMIT or OFFSET, to select the aggregated rows.
+func SELECT_JSON_ARR(projection Projection, projections ...Projection) SelectStatement {
+	return newSelectJSONArrStatement(append([]Projection{projection}, projections...))
+}
+
+var jsonAggregation = jet.JSONAggregation{
+	ObjectFunc: "JSON_OBJECT",
+	ArrayAgg:   "COALESCE(JSON_ARRAYAGG(%s), JSON_ARRAY())",
+	Unordered:  true,
+}
+
 func newSelectStatement(table ReadableTable, projections []Projection) SelectStatement {
 	newSelect := &selectStatementImpl{}
 	newSelect.ExpressionStatement = jet.NewExpressionStatementImpl(Dialect, jet.SelectStatementType, newSelect,
@@ -87,6 +122,7 @@ func newSelectStatement(table ReadableTable, projections []Projection) SelectSta
 	}
 	newSelect.Limit.Count = -1
 	newSelect.Offset.Count = -1
+	newSelect.For.From = &newSelect.From
 	newSelect.ShareLock.Name = "LOCK IN SHARE MODE"
 	newSelect.ShareLock.InNewLine = true
 
@@ -95,6 +131,32 @@ func newSelectStatement(table ReadableTable, projections []Projection) SelectSta
 	return newSelect
 }
 
+func newSelectJSONArrStatement(projections []Projection) SelectStatement {
+	newSelect := newSelectStatement(nil, nil).(*selectStatementImpl)
+	newSelect.Select.ProjectionList = []Projection{jet.NewJSONArrayAggProjection(jsonAggregation, projections, &newSelect.OrderBy)}
+	newSelect.ExpressionStatement = jet.NewExpressionStatementImpl(Dialect, jet.SelectStatementType, newSelect,
+		&jet.ClauseJSONArrayAgg{Aggregation: jsonAggregation, OrderBy: &newSelect.OrderBy}, &newSelect.Select,
+		&newSelect.From, &newSelect.Where, &newSelect.GroupBy, &newSelect.Having, &newSelect.Window,
+		jsonArrOrderBy{newSelect}, &newSelect.Limit, &newSelect.Offset, &newSelect.For, &newSelect.ShareLock,
+		&jet.ClauseJSONArrayAgg{Aggregation: jsonAggregation, End: true})
+
+	return newSelect
+}
+
+// jsonArrOrderBy serializes ORDER BY clause of SELECT_JSON_ARR statement only if statement has LIMIT or OFFSET clause.
+// JSON_ARRAYAGG does not preserve the order of aggregated rows, so ORDER BY can only select the rows to aggregate.
+type jsonArrOrderBy struct {
+	statement *selectStatementImpl
+}
+
+func (o jsonArrOrderBy) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if o.statement.Limit.Count < 0 && o.statement.Offset.Count < 0 {
+		return
+	}
+
+	o.statement.OrderBy.Serialize(statementType, out, options...)
+}
+
 type selectStatementImpl struct {
 	jet.ExpressionStatement
 	setOperatorsImpl
@@ -163,7 +225,7 @@ func (s *selectStatementImpl) OFFSET(offset int64) SelectStatement {
 }
 
 func (s *selectStatementImpl) FOR(lock RowLock) SelectStatement {
-	s.For.Lock = lock
+	s.For.Locks = []RowLock{lock}
 	return s
 }
 
@@ -176,6 +238,26 @@ func (s *selectStatementImpl) AsTable(alias string) SelectTable {
 	return newSelectTable(s, alias)
 }
 
+func (s *selectStatementImpl) CountStatement() Statement {
+	return jet.NewCountStatement(Dialect, &s.Select, &s.From, &s.Where, &s.GroupBy, &s.Having, &s.Window)
+}
+
+func (s *selectStatementImpl) QueryWithCount(ctx context.Context, db qrm.Queryable, destination interface{}) (totalCount int64, err error) {
+	return jet.QueryWithCount(ctx, db, s, s.CountStatement(), destination)
+}
+
+func (s *selectStatementImpl) JSONNestedStatement(destination interface{}) Statement {
+	nested := newSelectStatement(nil, nil).(*selectStatementImpl)
+	nested.Select, nested.From, nested.Where, nested.GroupBy = s.Select, s.From, s.Where, s.GroupBy
+	nested.Having, nested.Window, nested.OrderBy = s.Having, s.Window, s.OrderBy
+	nested.Limit, nested.Offset, nested.For.Locks, nested.ShareLock = s.Limit, s.Offset, s.For.Locks, s.ShareLock
+
+	jet.NestJSONProjections(Dialect, jsonAggregation, destination, &nested.Select, &nested.From, &nested.OrderBy,
+		&nested.Where, &nested.GroupBy, &nested.Having, &nested.Window)
+
+	return nested
+}
+
 //-----------------------------------------------------
 
 type windowExpand struct {
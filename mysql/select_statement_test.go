@@ -1,8 +1,12 @@
 package mysql
 
 import (
-	"github.com/go-jet/jet/v2/internal/testutils"
+	"context"
 	"testing"
+
+	"github.com/go-jet/jet/v2/internal/testutils"
+	"github.com/go-jet/jet/v2/qrm/qrmtest"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInvalidSelect(t *testing.T) {
@@ -125,6 +129,22 @@ FOR SHARE NOWAIT;
 `)
 }
 
+func TestSelectLockOf(t *testing.T) {
+	testutils.AssertStatementSql(t,
+		SELECT(table1ColInt).
+			FROM(table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))).
+			FOR(UPDATE().OF(table1, table2).NOWAIT()), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+     INNER JOIN db.table2 ON (table1.col_int = table2.col_int)
+FOR UPDATE OF table1, table2 NOWAIT;
+`)
+	assertStatementSqlErr(t,
+		SELECT(table1ColInt).FROM(table1).FOR(SHARE().OF(table3)),
+		"jet: row lock table 'table3' does not appear in FROM clause",
+	)
+}
+
 func TestSelect_LOCK_IN_SHARE_MODE(t *testing.T) {
 	testutils.AssertStatementSql(t, SELECT(table1ColBool).FROM(table1).LOCK_IN_SHARE_MODE(), `
 SELECT table1.col_bool AS "table1.col_bool"
@@ -154,3 +174,144 @@ WHERE NOT (EXISTS (
       ));
 `)
 }
+
+func TestSelectCountStatement(t *testing.T) {
+	stmt := SELECT(table1ColInt).
+		FROM(table1).
+		WHERE(table1ColBool.IS_TRUE()).
+		GROUP_BY(table1ColInt).
+		ORDER_BY(table1ColInt.DESC()).
+		LIMIT(10).
+		OFFSET(20)
+
+	testutils.AssertStatementSql(t, stmt.CountStatement(), `
+SELECT COUNT(*) AS "count"
+FROM (
+          SELECT table1.col_int AS "table1.col_int"
+          FROM db.table1
+          WHERE table1.col_bool IS TRUE
+          GROUP BY table1.col_int
+     ) AS count_subquery;
+`)
+}
+
+func TestSelectJSONArr(t *testing.T) {
+	assertStatementSql(t, SELECT(
+		table1ColInt,
+		SELECT_JSON_ARR(table2ColInt, table2ColFloat.ADD(Float(1.5)).AS("float_plus")).
+			FROM(table2).
+			WHERE(table2ColInt.EQ(table1ColInt)).
+			AS("table2s"),
+	).FROM(table1), `
+SELECT table1.col_int AS "table1.col_int",
+     (
+          SELECT JSON_OBJECT('__jet_rows', COALESCE(JSON_ARRAYAGG(records.j), JSON_ARRAY()))
+          FROM (
+               SELECT JSON_OBJECT(
+                         'table2.col_int', table2.col_int,
+                         'float_plus', (table2.col_float + ?)
+                    ) AS "j"
+               FROM db.table2
+               WHERE table2.col_int = table1.col_int
+          ) AS records
+     ) AS "table2s"
+FROM db.table1;
+`, 1.5)
+
+	assertStatementSql(t, SELECT_JSON_ARR(table2ColInt).FROM(table2).ORDER_BY(table2ColInt), `
+SELECT JSON_OBJECT('__jet_rows', COALESCE(JSON_ARRAYAGG(records.j), JSON_ARRAY()))
+FROM (
+     SELECT JSON_OBJECT(
+               'table2.col_int', table2.col_int
+          ) AS "j"
+     FROM db.table2
+) AS records;
+`)
+
+	assertStatementSql(t, SELECT_JSON_ARR(table2ColInt).FROM(table2).ORDER_BY(table2ColInt).LIMIT(3), `
+SELECT JSON_OBJECT('__jet_rows', COALESCE(JSON_ARRAYAGG(records.j), JSON_ARRAY()))
+FROM (
+     SELECT JSON_OBJECT(
+               'table2.col_int', table2.col_int
+          ) AS "j"
+     FROM db.table2
+     ORDER BY table2.col_int
+     LIMIT ?
+) AS records;
+`, int64(3))
+}
+
+func TestSelectJSONNestedStatement(t *testing.T) {
+	var dest []struct {
+		ColInt  int32 `sql:"primary_key" alias:"table1.col_int"`
+		Table2s []struct {
+			ColInt  int32 `sql:"primary_key" alias:"table2.col_int"`
+			Table3s []struct {
+				Col1 int32 `sql:"primary_key" alias:"table3.col1"`
+			}
+		}
+	}
+
+	stmt := SELECT(
+		table1ColInt,
+		table2ColInt,
+		table3Col1,
+	).FROM(
+		table1.
+			INNER_JOIN(table2, table2ColInt.EQ(table1ColInt)).
+			INNER_JOIN(table3, table3ColInt.EQ(table2ColInt)),
+	).ORDER_BY(
+		table1ColInt,
+		table2ColInt,
+		table3Col1,
+	)
+
+	assertStatementSql(t, stmt.JSONNestedStatement(&dest), `
+SELECT table1.col_int AS "table1.col_int",
+     (
+          SELECT JSON_OBJECT('__jet_rows', COALESCE(JSON_ARRAYAGG(records.j), JSON_ARRAY()))
+          FROM (
+               SELECT JSON_OBJECT(
+                         'table2.col_int', table2.col_int,
+                         'table3s', (
+                              SELECT JSON_OBJECT('__jet_rows', COALESCE(JSON_ARRAYAGG(records.j), JSON_ARRAY()))
+                              FROM (
+                                   SELECT JSON_OBJECT(
+                                             'table3.col1', table3.col1
+                                        ) AS "j"
+                                   FROM db.table3
+                                   WHERE table3.col_int = table2.col_int
+                              ) AS records
+                         )
+                    ) AS "j"
+               FROM db.table2
+               WHERE table2.col_int = table1.col_int
+          ) AS records
+     ) AS "table2s"
+FROM db.table1
+ORDER BY table1.col_int;
+`)
+}
+
+func TestSelectQueryWithCount(t *testing.T) {
+	stmt := SELECT(table1ColInt).
+		FROM(table1).
+		ORDER_BY(table1ColInt).
+		LIMIT(2)
+
+	db := qrmtest.New()
+	db.ExpectStatement(stmt).WillReturnRows(qrmtest.NewRows("table1.col_int").AddRow(1).AddRow(2))
+	db.ExpectStatement(stmt.CountStatement()).WillReturnRows(qrmtest.NewRows("count").AddRow(11))
+
+	var dest []struct {
+		ColInt int64 `alias:"table1.col_int"`
+	}
+
+	totalCount, err := stmt.QueryWithCount(context.Background(), db, &dest)
+
+	require.NoError(t, err)
+	require.NoError(t, db.ExpectationsWereMet())
+	require.Len(t, dest, 2)
+	require.Equal(t, int64(2), dest[1].ColInt)
+	require.Equal(t, int64(11), totalCount)
+}
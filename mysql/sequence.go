@@ -0,0 +1,36 @@
+package mysql
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// Sequence is interface for MariaDB sequence objects. MySQL does not support sequences.
+type Sequence interface {
+	jet.Sequence
+
+	// FromSchema creates new sequence from the same sequence name in a different schema
+	FromSchema(schemaName string) Sequence
+}
+
+type sequenceImpl struct {
+	jet.Sequence
+}
+
+// NewSequence creates new sequence with the schema name and sequence name. CURRVAL is serialized as MariaDB
+// LASTVAL function, and SETVAL value is inlined into the query, because MariaDB SETVAL accepts only constant value.
+//
+//	FilmSeq := NewSequence("dvds", "film_seq")
+//	SELECT(FilmSeq.NEXTVAL())
+func NewSequence(schemaName, name string) Sequence {
+	return &sequenceImpl{
+		Sequence: jet.NewSequence(jet.SequenceFunctions{
+			NextVal: "NEXTVAL",
+			CurrVal: "LASTVAL",
+			SetVal:  "SETVAL",
+
+			InlineSetValValue: true,
+		}, schemaName, name),
+	}
+}
+
+func (s *sequenceImpl) FromSchema(schemaName string) Sequence {
+	return NewSequence(schemaName, s.SequenceName())
+}
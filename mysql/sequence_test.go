@@ -0,0 +1,14 @@
+package mysql
+
+import "testing"
+
+func TestSequence(t *testing.T) {
+	filmSeq := NewSequence("dvds", "film_seq")
+
+	assertSerialize(t, filmSeq.NEXTVAL(), "NEXTVAL(dvds.film_seq)")
+	assertSerialize(t, filmSeq.CURRVAL(), "LASTVAL(dvds.film_seq)")
+	assertSerialize(t, filmSeq.SETVAL(Int(100)), "SETVAL(dvds.film_seq, 100)")
+
+	assertSerialize(t, filmSeq.FromSchema("test").NEXTVAL(), "NEXTVAL(test.film_seq)")
+	assertSerialize(t, NewSequence("", "Film_Seq").NEXTVAL(), "NEXTVAL(`Film_Seq`)")
+}
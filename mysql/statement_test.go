@@ -0,0 +1,22 @@
+package mysql
+
+import (
+	"testing"
+)
+
+func TestMultiStatement(t *testing.T) {
+	stmt := MultiStatement(
+		SELECT(table1ColInt).FROM(table1).WHERE(table1ColInt.GT(Int(10))).LIMIT(5),
+		SELECT(COUNT(STAR)).FROM(table1).WHERE(table1ColInt.GT(Int(10))),
+	)
+
+	assertStatementSql(t, stmt, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int > ?
+LIMIT ?;
+SELECT COUNT(*)
+FROM db.table1
+WHERE table1.col_int > ?;
+`, int64(10), int64(5), int64(10))
+}
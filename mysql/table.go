@@ -8,6 +8,8 @@ type Table interface {
 	readableTable
 
 	INSERT(columns ...jet.Column) InsertStatement
+	INSERT_IGNORE(columns ...jet.Column) InsertStatement
+	REPLACE(columns ...jet.Column) ReplaceStatement
 	UPDATE(columns ...jet.Column) UpdateStatement
 	DELETE() DeleteStatement
 	LOCK() LockStatement
@@ -35,7 +37,10 @@ type readableTable interface {
 
 type joinSelectUpdateTable interface {
 	ReadableTable
+	// UPDATE creates multiple-table UPDATE statement. Columns of any joined table can be assigned.
 	UPDATE(columns ...jet.Column) UpdateStatement
+	// DELETE creates multiple-table DELETE statement, deleting matching rows from each of the listed joined tables.
+	DELETE(table Table, tables ...Table) DeleteStatement
 }
 
 // ReadableTable interface
@@ -78,8 +83,13 @@ func (r readableTableInterfaceImpl) CROSS_JOIN(table ReadableTable) joinSelectUp
 
 // NewTable creates new table with schema Name, table Name and list of columns
 func NewTable(schemaName, name, alias string, columns ...jet.ColumnExpression) Table {
+	return NewTableWithPrimaryKey(schemaName, name, alias, nil, columns...)
+}
+
+// NewTableWithPrimaryKey creates new table with schema Name, table Name, list of primary key columns and list of columns
+func NewTableWithPrimaryKey(schemaName, name, alias string, primaryKey ColumnList, columns ...jet.ColumnExpression) Table {
 	t := &tableImpl{
-		SerializerTable: jet.NewTable(schemaName, name, alias, columns...),
+		SerializerTable: jet.NewTableWithPrimaryKey(schemaName, name, alias, primaryKey, columns...),
 	}
 
 	t.readableTableInterfaceImpl.parent = t
@@ -98,6 +108,16 @@ func (t *tableImpl) INSERT(columns ...jet.Column) InsertStatement {
 	return newInsertStatement(t.parent, jet.UnwidColumnList(columns))
 }
 
+func (t *tableImpl) INSERT_IGNORE(columns ...jet.Column) InsertStatement {
+	insert := newInsertStatement(t.parent, jet.UnwidColumnList(columns)).(*insertStatementImpl)
+	insert.Insert.Ignore = true
+	return insert
+}
+
+func (t *tableImpl) REPLACE(columns ...jet.Column) ReplaceStatement {
+	return newReplaceStatement(t.parent, jet.UnwidColumnList(columns))
+}
+
 func (t *tableImpl) UPDATE(columns ...jet.Column) UpdateStatement {
 	return newUpdateStatement(t.parent, jet.UnwidColumnList(columns))
 }
@@ -111,17 +131,30 @@ func (t *tableImpl) LOCK() LockStatement {
 }
 
 type joinTable struct {
-	tableImpl
+	readableTableInterfaceImpl
 	jet.JoinTable
 }
 
-func newJoinTable(lhs jet.Serializer, rhs jet.Serializer, joinType jet.JoinType, onCondition BoolExpression) Table {
+func newJoinTable(lhs jet.Serializer, rhs jet.Serializer, joinType jet.JoinType, onCondition BoolExpression) joinSelectUpdateTable {
 	newJoinTable := &joinTable{
 		JoinTable: jet.NewJoinTable(lhs, rhs, joinType, onCondition),
 	}
 
 	newJoinTable.readableTableInterfaceImpl.parent = newJoinTable
-	newJoinTable.parent = newJoinTable
 
 	return newJoinTable
 }
+
+func (t *joinTable) UPDATE(columns ...jet.Column) UpdateStatement {
+	return newUpdateStatement(t, jet.UnwidColumnList(columns))
+}
+
+func (t *joinTable) DELETE(table Table, tables ...Table) DeleteStatement {
+	deleteStatement := newDeleteStatement(t).(*deleteStatementImpl)
+
+	for _, target := range append([]Table{table}, tables...) {
+		deleteStatement.Delete.Targets = append(deleteStatement.Delete.Targets, target)
+	}
+
+	return deleteStatement
+}
@@ -0,0 +1,22 @@
+package mysql
+
+import (
+	"context"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// Tx is database transaction started by WithTx
+type Tx = jet.Tx
+
+// TxOptions holds options for WithTx
+type TxOptions = jet.TxOptions
+
+// WithTx executes fn inside database transaction. Transaction is committed if fn returns nil, otherwise it is rolled
+// back and fn error is returned. If db is *sql.DB or *sql.Conn new transaction is started, and if transaction fails
+// with serialization failure or deadlock whole transaction is retried with backoff. If db is already a transaction
+// (*Tx or *sql.Tx), fn is executed inside nested savepoint. If opts is nil, transaction is retried up to 3 times.
+func WithTx(ctx context.Context, db qrm.Executable, opts *TxOptions, fn func(tx *Tx) error) error {
+	return jet.WithTx(ctx, Dialect, db, opts, fn)
+}
@@ -1,6 +1,17 @@
 package mysql
 
-import "github.com/go-jet/jet/v2/internal/jet"
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// BeforeUpdateHook can be implemented by model types to modify model before it is used by UPDATE statement MODEL
+// and MODELS methods. Hook is called on statement execution, on a copy of the model taken by MODEL or MODELS,
+// and error returned from BeforeUpdate is returned from the execution. Sql and DebugSql do not call the hook.
+type BeforeUpdateHook = jet.BeforeUpdateHook
 
 // UpdateStatement is interface of SQL UPDATE statement
 type UpdateStatement interface {
@@ -10,8 +21,17 @@ type UpdateStatement interface {
 
 	SET(value interface{}, values ...interface{}) UpdateStatement
 	MODEL(data interface{}) UpdateStatement
+	// MODELS updates table rows with the values of the list of models. Rows are matched on the table primary key
+	// columns, which are also extracted from the models. If data is not a slice of structures or table does not
+	// have primary key columns, this method will panic.
+	MODELS(data interface{}) UpdateStatement
 
 	WHERE(expression BoolExpression) UpdateStatement
+
+	// ExecInBatches executes statement over db connection/transaction as a sequence of statements, each containing
+	// a subset of MODELS rows, so that no statement exceeds MySQL limit on the number of parametrized arguments.
+	// Returned result contains the total number of rows affected.
+	ExecInBatches(ctx context.Context, db qrm.Executable) (sql.Result, error)
 }
 
 type updateStatementImpl struct {
@@ -21,14 +41,17 @@ type updateStatementImpl struct {
 	Set    jet.SetClause
 	SetNew jet.SetClauseNew
 	Where  jet.ClauseWhere
+
+	Models clauseUpdateModels
 }
 
-func newUpdateStatement(table Table, columns []jet.Column) UpdateStatement {
+func newUpdateStatement(table jet.SerializerTable, columns []jet.Column) UpdateStatement {
 	update := &updateStatementImpl{}
+
 	update.SerializerStatement = jet.NewStatementImpl(Dialect, jet.UpdateStatementType, update,
 		&update.Update,
-		&update.Set,
-		&update.SetNew,
+		qualifiedForJoinTable(table, &update.Set),
+		qualifiedForJoinTable(table, &update.SetNew),
 		&update.Where)
 
 	update.Update.Table = table
@@ -59,11 +82,115 @@ func (u *updateStatementImpl) SET(value interface{}, values ...interface{}) Upda
 }
 
 func (u *updateStatementImpl) MODEL(data interface{}) UpdateStatement {
-	u.Set.Values = jet.UnwindRowFromModel(u.Set.Columns, data)
+	u.Set.Values = jet.UnwindRowFromModel(jet.UpdateStatementType, u.Set.Columns, data)
 	return u
 }
 
+func (u *updateStatementImpl) MODELS(data interface{}) UpdateStatement {
+	u.setModels(jet.NewUpdateModels(u.Update.Table, u.Set.Columns, data))
+	return u
+}
+
+func (u *updateStatementImpl) setModels(models *jet.UpdateModels) {
+	u.Models.UpdateModels = models
+	u.Where.Mandatory = false
+
+	u.SerializerStatement = jet.NewStatementImpl(Dialect, jet.UpdateStatementType, u,
+		&u.Update,
+		qualifiedForJoinTable(u.Update.Table, &u.Models),
+		&u.Where)
+}
+
 func (u *updateStatementImpl) WHERE(expression BoolExpression) UpdateStatement {
 	u.Where.Condition = expression
 	return u
 }
+
+func (u *updateStatementImpl) ExecInBatches(ctx context.Context, db qrm.Executable) (sql.Result, error) {
+	if u.Models.UpdateModels == nil {
+		return u.ExecContext(ctx, db)
+	}
+
+	return jet.ExecInBatches(ctx, db, Dialect, maxArgumentsPerStatement, u.Models.Rows, u.batchStatement)
+}
+
+func (u *updateStatementImpl) batchStatement(rows [][]jet.Serializer) Statement {
+	batch := newUpdateStatement(nil, nil).(*updateStatementImpl)
+	batch.Update = u.Update
+	batch.Where = u.Where
+	batch.setModels(u.Models.WithRows(rows))
+
+	return batch
+}
+
+// clauseUpdateModels serializes JOIN and SET clauses of UPDATE MODELS statement:
+//
+//	INNER JOIN (SELECT ? AS pk, ? AS col UNION ALL SELECT ?, ?) AS v ON table.pk = v.pk
+//	SET table.col = v.col
+type clauseUpdateModels struct {
+	*jet.UpdateModels
+}
+
+func (m *clauseUpdateModels) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	m.CheckRows(out)
+
+	out.NewLine()
+	out.WriteString("INNER JOIN (")
+	out.IncreaseIdent()
+
+	for rowIndex, row := range m.Rows {
+		if rowIndex > 0 {
+			out.NewLine()
+			out.WriteString("UNION ALL")
+		}
+
+		out.NewLine()
+		out.WriteString("SELECT")
+
+		for i, value := range row {
+			if i > 0 {
+				out.WriteString(", ")
+			}
+
+			jet.Serialize(value, statementType, out)
+
+			if rowIndex == 0 {
+				out.WriteString("AS")
+				out.WriteIdentifier(m.ValueColumns()[i].Name())
+			}
+		}
+	}
+
+	out.DecreaseIdent()
+	out.NewLine()
+	out.WriteString(")")
+	out.WriteString("AS")
+	out.WriteIdentifier(jet.UpdateModelsAlias)
+	out.WriteString("ON")
+	m.SerializeCondition(statementType, out, nil)
+
+	out.NewLine()
+	out.WriteString("SET")
+	m.SerializeAssignments(out, true)
+}
+
+// qualifiedColumnsClause serializes SET clause of multiple-table UPDATE statement, where assigned columns are
+// qualified with table name:
+//
+//	SET table1.col = table2.col
+type qualifiedColumnsClause struct {
+	jet.Clause
+}
+
+// qualifiedForJoinTable wraps clause into qualifiedColumnsClause if updated table is join table
+func qualifiedForJoinTable(table jet.SerializerTable, clause jet.Clause) jet.Clause {
+	if _, isJoinTable := table.(*joinTable); isJoinTable {
+		return qualifiedColumnsClause{clause}
+	}
+
+	return clause
+}
+
+func (q qualifiedColumnsClause) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	q.Clause.Serialize(statementType, out, jet.QualifiedColumnNames.WithFallTrough(options)...)
+}
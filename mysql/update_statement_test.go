@@ -1,9 +1,14 @@
 package mysql
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestUpdateWithOneValue(t *testing.T) {
@@ -80,3 +85,149 @@ func TestInvalidInputs(t *testing.T) {
 	assertStatementSqlErr(t, table1.UPDATE(table1ColInt).SET(1), "jet: WHERE clause not set")
 	assertStatementSqlErr(t, table1.UPDATE(nil).SET(1), "jet: nil column in columns list for SET clause")
 }
+
+var table1WithPK = NewTableWithPrimaryKey("db", "table1", "", ColumnList{table1Col1}, table1Col1, table1ColInt, table1ColFloat)
+
+type updateModel struct {
+	Col1     int
+	ColInt   int
+	ColFloat float64
+}
+
+func TestUpdateModels(t *testing.T) {
+	expectedSQL := `
+UPDATE db.table1
+INNER JOIN (
+     SELECT ? AS col1, ? AS col_int, ? AS col_float
+     UNION ALL
+     SELECT ?, ?, ?
+) AS v ON table1.col1 = v.col1
+SET table1.col_int = v.col_int,
+    table1.col_float = v.col_float
+WHERE table1.col_int < ?;
+`
+	stmt := table1WithPK.UPDATE(table1ColInt, table1ColFloat).
+		MODELS([]updateModel{
+			{Col1: 1, ColInt: 10, ColFloat: 1.1},
+			{Col1: 2, ColInt: 20, ColFloat: 2.2},
+		}).
+		WHERE(table1ColInt.LT(Int(100)))
+
+	assertStatementSql(t, stmt, expectedSQL, 1, 10, 1.1, 2, 20, 2.2, int64(100))
+}
+
+func TestUpdateModelsInvalidInputs(t *testing.T) {
+	require.PanicsWithValue(t, "jet: UPDATE MODELS requires table with primary key columns", func() {
+		table1.UPDATE(table1ColInt).MODELS([]updateModel{})
+	})
+}
+
+type execRecorder struct {
+	queries []string
+	args    [][]interface{}
+}
+
+func (e *execRecorder) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	e.queries = append(e.queries, query)
+	e.args = append(e.args, args)
+	return driver.RowsAffected(len(args) / 3), nil
+}
+
+func TestUpdateModels_ExecInBatches(t *testing.T) {
+	var models []updateModel
+
+	for i := 0; i < 30000; i++ {
+		models = append(models, updateModel{Col1: i})
+	}
+
+	stmt := table1WithPK.UPDATE(table1ColInt, table1ColFloat).
+		MODELS(models)
+
+	db := &execRecorder{}
+
+	res, err := stmt.ExecInBatches(context.Background(), db)
+	require.NoError(t, err)
+
+	rowsAffected, err := res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(30000), rowsAffected)
+
+	require.Len(t, db.queries, 2)
+	require.Len(t, db.args[0], 65535)
+	require.Len(t, db.args[1], 90000-65535)
+	require.Contains(t, db.queries[1], "SELECT ? AS col1, ? AS col_int, ? AS col_float")
+	require.Contains(t, db.queries[1], "SET table1.col_int = v.col_int")
+}
+
+func TestUpdateJoinTable(t *testing.T) {
+	stmt := table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt)).
+		UPDATE(table1ColFloat, table1ColString).
+		SET(table2ColFloat, "str").
+		WHERE(table2ColBool.IS_TRUE())
+
+	assertStatementSql(t, stmt, `
+UPDATE db.table1
+INNER JOIN db.table2 ON (table1.col_int = table2.col_int)
+SET table1.col_float = table2.col_float,
+    table1.col_string = ?
+WHERE table2.col_bool IS TRUE;
+`, "str")
+
+	stmt = table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt)).
+		UPDATE().
+		SET(
+			table1ColFloat.SET(table2ColFloat),
+			table2ColStr.SET(String("str")),
+		).
+		WHERE(table2ColBool.IS_TRUE())
+
+	assertStatementSql(t, stmt, `
+UPDATE db.table1
+INNER JOIN db.table2 ON (table1.col_int = table2.col_int)
+SET table1.col_float = table2.col_float,
+    table2.col_str = ?
+WHERE table2.col_bool IS TRUE;
+`, "str")
+
+	stmt = table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt)).
+		UPDATE(table1ColFloat, table2ColStr).
+		MODEL(struct {
+			ColFloat float64
+			ColStr   string
+		}{ColFloat: 1.1, ColStr: "str"}).
+		WHERE(table2ColBool.IS_TRUE())
+
+	assertStatementSql(t, stmt, `
+UPDATE db.table1
+INNER JOIN db.table2 ON (table1.col_int = table2.col_int)
+SET table1.col_float = ?,
+    table2.col_str = ?
+WHERE table2.col_bool IS TRUE;
+`, 1.1, "str")
+}
+
+func TestUpdateJoinTableModels(t *testing.T) {
+	require.PanicsWithValue(t, "jet: UPDATE MODELS requires table with primary key columns", func() {
+		table1WithPK.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt)).
+			UPDATE(table1ColFloat).
+			MODELS([]updateModel{})
+	})
+}
+
+func TestUpdateModelsEmpty(t *testing.T) {
+	stmt := table1WithPK.UPDATE(table1ColInt, table1ColFloat).
+		MODELS([]updateModel{})
+
+	db := &execRecorder{}
+
+	_, err := stmt.ExecContext(context.Background(), db)
+	require.EqualError(t, err, "jet: UPDATE MODELS has no rows, models slice is empty")
+
+	res, err := stmt.ExecInBatches(context.Background(), db)
+	require.NoError(t, err)
+
+	rowsAffected, err := res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), rowsAffected)
+	require.Empty(t, db.queries)
+}
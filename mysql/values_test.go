@@ -0,0 +1,48 @@
+package mysql
+
+import "testing"
+
+func TestValuesTable(t *testing.T) {
+	id, code := IntegerColumn("id"), StringColumn("code")
+
+	codes := VALUES(
+		ROW(Int(1), String("a")),
+		ROW(Int(2), String("b")),
+	).AS("codes", id, code)
+
+	stmt := SELECT(
+		table1ColFloat, code.From(codes),
+	).FROM(
+		table1.INNER_JOIN(codes, table1ColInt.EQ(id.From(codes))),
+	)
+
+	assertStatementSql(t, stmt, `
+SELECT table1.col_float AS "table1.col_float",
+     codes.code AS "code"
+FROM db.table1
+     INNER JOIN (
+          VALUES ROW(?, ?),
+                 ROW(?, ?)
+     ) AS codes (id, code) ON (table1.col_int = codes.id);
+`, int64(1), "a", int64(2), "b")
+}
+
+func TestValuesTableModels(t *testing.T) {
+	type Code struct {
+		ID   int64
+		Code string
+	}
+
+	id, code := IntegerColumn("id"), StringColumn("code")
+
+	codes := VALUES(ROW(Int(1), String("a"))).MODELS([]Code{{ID: 2, Code: "b"}}).AS("codes", id, code)
+
+	assertStatementSql(t, SELECT(codes.AllColumns()).FROM(codes), `
+SELECT codes.id AS "id",
+     codes.code AS "code"
+FROM (
+          VALUES ROW(?, ?),
+                 ROW(?, ?)
+     ) AS codes (id, code);
+`, int64(1), "a", int64(2), "b")
+}
@@ -43,6 +43,10 @@ type cast interface {
 	AS_TIMESTAMPZ() TimestampzExpression
 	// Cast expression AS interval type
 	AS_INTERVAL() IntervalExpression
+	// Cast expression AS tsvector type
+	AS_TSVECTOR() TsVectorExpression
+	// Cast expression AS tsquery type
+	AS_TSQUERY() TsQueryExpression
 }
 
 type castImpl struct {
@@ -159,3 +163,13 @@ func (b *castImpl) AS_TIMESTAMPZ() TimestampzExpression {
 func (b *castImpl) AS_INTERVAL() IntervalExpression {
 	return IntervalExp(b.AS("interval"))
 }
+
+// Cast expression AS tsvector type
+func (b *castImpl) AS_TSVECTOR() TsVectorExpression {
+	return TsVectorExp(b.AS("tsvector"))
+}
+
+// Cast expression AS tsquery type
+func (b *castImpl) AS_TSQUERY() TsQueryExpression {
+	return TsQueryExp(b.AS("tsquery"))
+}
@@ -95,3 +95,169 @@ func IntervalColumn(name string) ColumnInterval {
 	intervalColumn.intervalInterfaceImpl.parent = intervalColumn
 	return intervalColumn
 }
+
+//------------------------------------------------------//
+
+// ColumnTsVector is interface of PostgreSQL tsvector columns.
+type ColumnTsVector interface {
+	TsVectorExpression
+	jet.Column
+
+	From(subQuery SelectTable) ColumnTsVector
+}
+
+type tsVectorColumnImpl struct {
+	jet.ColumnExpressionImpl
+	tsVectorInterfaceImpl
+}
+
+func (t *tsVectorColumnImpl) From(subQuery SelectTable) ColumnTsVector {
+	newTsVectorColumn := TsVectorColumn(t.Name())
+	jet.SetTableName(newTsVectorColumn, t.TableName())
+	jet.SetSubQuery(newTsVectorColumn, subQuery)
+
+	return newTsVectorColumn
+}
+
+// TsVectorColumn creates named tsvector column.
+func TsVectorColumn(name string) ColumnTsVector {
+	tsVectorColumn := &tsVectorColumnImpl{}
+	tsVectorColumn.ColumnExpressionImpl = jet.NewColumnImpl(name, "", tsVectorColumn)
+	tsVectorColumn.tsVectorInterfaceImpl.parent = tsVectorColumn
+	return tsVectorColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnTsQuery is interface of PostgreSQL tsquery columns.
+type ColumnTsQuery interface {
+	TsQueryExpression
+	jet.Column
+
+	From(subQuery SelectTable) ColumnTsQuery
+}
+
+type tsQueryColumnImpl struct {
+	jet.ColumnExpressionImpl
+	tsQueryInterfaceImpl
+}
+
+func (t *tsQueryColumnImpl) From(subQuery SelectTable) ColumnTsQuery {
+	newTsQueryColumn := TsQueryColumn(t.Name())
+	jet.SetTableName(newTsQueryColumn, t.TableName())
+	jet.SetSubQuery(newTsQueryColumn, subQuery)
+
+	return newTsQueryColumn
+}
+
+// TsQueryColumn creates named tsquery column.
+func TsQueryColumn(name string) ColumnTsQuery {
+	tsQueryColumn := &tsQueryColumnImpl{}
+	tsQueryColumn.ColumnExpressionImpl = jet.NewColumnImpl(name, "", tsQueryColumn)
+	tsQueryColumn.tsQueryInterfaceImpl.parent = tsQueryColumn
+	return tsQueryColumn
+}
+
+//------------------------------------------------------//
+
+// ColumnRange is interface of PostgreSQL range columns with elements of type T.
+type ColumnRange[T Expression] interface {
+	RangeExpression[T]
+	jet.Column
+
+	From(subQuery SelectTable) ColumnRange[T]
+}
+
+// Range column types
+type (
+	ColumnInt4Range = ColumnRange[Int4RangeElement]
+	ColumnInt8Range = ColumnRange[Int8RangeElement]
+	ColumnNumRange  = ColumnRange[NumRangeElement]
+	ColumnTsRange   = ColumnRange[TsRangeElement]
+	ColumnTsTzRange = ColumnRange[TsTzRangeElement]
+	ColumnDateRange = ColumnRange[DateRangeElement]
+)
+
+type rangeColumnImpl[T Expression] struct {
+	jet.ColumnExpressionImpl
+	rangeInterfaceImpl[T]
+}
+
+func (r *rangeColumnImpl[T]) From(subQuery SelectTable) ColumnRange[T] {
+	newRangeColumn := RangeColumn[T](r.Name())
+	jet.SetTableName(newRangeColumn, r.TableName())
+	jet.SetSubQuery(newRangeColumn, subQuery)
+
+	return newRangeColumn
+}
+
+// RangeColumn creates named range column with elements of type T.
+func RangeColumn[T Expression](name string) ColumnRange[T] {
+	rangeColumn := &rangeColumnImpl[T]{}
+	rangeColumn.ColumnExpressionImpl = jet.NewColumnImpl(name, "", rangeColumn)
+	rangeColumn.rangeInterfaceImpl.setParent(rangeColumn)
+	return rangeColumn
+}
+
+// Range column constructors
+var (
+	Int4RangeColumn = RangeColumn[Int4RangeElement]
+	Int8RangeColumn = RangeColumn[Int8RangeElement]
+	NumRangeColumn  = RangeColumn[NumRangeElement]
+	TsRangeColumn   = RangeColumn[TsRangeElement]
+	TsTzRangeColumn = RangeColumn[TsTzRangeElement]
+	DateRangeColumn = RangeColumn[DateRangeElement]
+)
+
+//------------------------------------------------------//
+
+// ColumnMultiRange is interface of PostgreSQL multirange columns with elements of type T, consisting of ranges
+// with elements of type R.
+type ColumnMultiRange[T Expression, R Expression] interface {
+	MultiRangeExpression[T, R]
+	jet.Column
+
+	From(subQuery SelectTable) ColumnMultiRange[T, R]
+}
+
+// Multirange column types
+type (
+	ColumnInt4MultiRange = ColumnMultiRange[Int4MultiRangeElement, Int4RangeElement]
+	ColumnInt8MultiRange = ColumnMultiRange[Int8MultiRangeElement, Int8RangeElement]
+	ColumnNumMultiRange  = ColumnMultiRange[NumMultiRangeElement, NumRangeElement]
+	ColumnTsMultiRange   = ColumnMultiRange[TsMultiRangeElement, TsRangeElement]
+	ColumnTsTzMultiRange = ColumnMultiRange[TsTzMultiRangeElement, TsTzRangeElement]
+	ColumnDateMultiRange = ColumnMultiRange[DateMultiRangeElement, DateRangeElement]
+)
+
+type multiRangeColumnImpl[T Expression, R Expression] struct {
+	jet.ColumnExpressionImpl
+	multiRangeInterfaceImpl[T, R]
+}
+
+func (m *multiRangeColumnImpl[T, R]) From(subQuery SelectTable) ColumnMultiRange[T, R] {
+	newMultiRangeColumn := MultiRangeColumn[T, R](m.Name())
+	jet.SetTableName(newMultiRangeColumn, m.TableName())
+	jet.SetSubQuery(newMultiRangeColumn, subQuery)
+
+	return newMultiRangeColumn
+}
+
+// MultiRangeColumn creates named multirange column with elements of type T, consisting of ranges with elements
+// of type R.
+func MultiRangeColumn[T Expression, R Expression](name string) ColumnMultiRange[T, R] {
+	multiRangeColumn := &multiRangeColumnImpl[T, R]{}
+	multiRangeColumn.ColumnExpressionImpl = jet.NewColumnImpl(name, "", multiRangeColumn)
+	multiRangeColumn.multiRangeInterfaceImpl.setParent(multiRangeColumn)
+	return multiRangeColumn
+}
+
+// Multirange column constructors
+var (
+	Int4MultiRangeColumn = MultiRangeColumn[Int4MultiRangeElement, Int4RangeElement]
+	Int8MultiRangeColumn = MultiRangeColumn[Int8MultiRangeElement, Int8RangeElement]
+	NumMultiRangeColumn  = MultiRangeColumn[NumMultiRangeElement, NumRangeElement]
+	TsMultiRangeColumn   = MultiRangeColumn[TsMultiRangeElement, TsRangeElement]
+	TsTzMultiRangeColumn = MultiRangeColumn[TsTzMultiRangeElement, TsTzRangeElement]
+	DateMultiRangeColumn = MultiRangeColumn[DateMultiRangeElement, DateRangeElement]
+)
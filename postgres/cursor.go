@@ -0,0 +1,396 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// DeclareStatement is interface for PostgreSQL DECLARE cursor statement
+type DeclareStatement interface {
+	Statement
+
+	// SCROLL allows cursor to fetch rows in nonsequential fashion (backward fetches)
+	SCROLL() DeclareStatement
+	// NO_SCROLL forbids cursor to fetch rows in nonsequential fashion
+	NO_SCROLL() DeclareStatement
+	// WITH_HOLD allows cursor to be used after the transaction that created it successfully commits
+	WITH_HOLD() DeclareStatement
+
+	cursorName() string
+}
+
+type declareCursor struct {
+	name string
+}
+
+// DECLARE creates new statement declaring cursor with the name. Cursor query is set with CURSOR_FOR method.
+// Unless declared WITH HOLD, cursor can be used only within the transaction it has been declared in.
+//
+//	DECLARE("film_cursor").CURSOR_FOR(SELECT(Film.AllColumns).FROM(Film)).WITH_HOLD()
+func DECLARE(name string) declareCursor {
+	return declareCursor{name: name}
+}
+
+// CURSOR_FOR sets select statement cursor returns the rows of
+func (d declareCursor) CURSOR_FOR(selectStatement SelectStatement) DeclareStatement {
+	newDeclare := &declareStatementImpl{}
+	newDeclare.SerializerStatement = jet.NewStatementImpl(Dialect, jet.DeclareStatementType, newDeclare,
+		&newDeclare.Declare)
+
+	newDeclare.Declare.name = d.name
+	newDeclare.Declare.query = selectStatement
+
+	return newDeclare
+}
+
+type declareStatementImpl struct {
+	jet.SerializerStatement
+
+	Declare clauseDeclare
+}
+
+func (d *declareStatementImpl) SCROLL() DeclareStatement {
+	d.Declare.scroll = "SCROLL"
+	return d
+}
+
+func (d *declareStatementImpl) NO_SCROLL() DeclareStatement {
+	d.Declare.scroll = "NO SCROLL"
+	return d
+}
+
+func (d *declareStatementImpl) WITH_HOLD() DeclareStatement {
+	d.Declare.withHold = true
+	return d
+}
+
+func (d *declareStatementImpl) cursorName() string {
+	return d.Declare.name
+}
+
+type clauseDeclare struct {
+	name     string
+	scroll   string
+	withHold bool
+	query    SelectStatement
+}
+
+func (c *clauseDeclare) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if c.query == nil {
+		panic("jet: cursor query is nil")
+	}
+
+	out.NewLine()
+	out.WriteString("DECLARE")
+	out.WriteIdentifier(c.name)
+
+	if c.scroll != "" {
+		out.WriteString(c.scroll)
+	}
+
+	out.WriteString("CURSOR")
+
+	if c.withHold {
+		out.WriteString("WITH HOLD")
+	}
+
+	out.WriteString("FOR")
+
+	jet.Serialize(c.query, statementType, out, jet.NoWrap)
+}
+
+type cursorCommand struct {
+	statementType jet.StatementType
+	count         int64
+}
+
+// FETCH creates new statement retrieving the next count rows using a cursor. If count is negative,
+// the prior count rows are fetched. Cursor name is set with FROM method.
+//
+//	FETCH(100).FROM("film_cursor")
+func FETCH(count int64) cursorCommand {
+	return cursorCommand{statementType: jet.FetchStatementType, count: count}
+}
+
+// MOVE creates new statement repositioning a cursor count rows forward (or backward if count is negative),
+// without retrieving any data. Cursor name is set with FROM method.
+func MOVE(count int64) cursorCommand {
+	return cursorCommand{statementType: jet.MoveStatementType, count: count}
+}
+
+// FROM sets the name of the cursor
+func (c cursorCommand) FROM(cursor string) Statement {
+	newCommand := &cursorStatementImpl{}
+	newCommand.SerializerStatement = jet.NewStatementImpl(Dialect, c.statementType, newCommand, &newCommand.Command)
+
+	newCommand.Command.count = &c.count
+	newCommand.Command.cursor = cursor
+
+	return newCommand
+}
+
+// CLOSE creates new statement closing the cursor
+func CLOSE(cursor string) Statement {
+	newClose := &cursorStatementImpl{}
+	newClose.SerializerStatement = jet.NewStatementImpl(Dialect, jet.CloseStatementType, newClose, &newClose.Command)
+
+	newClose.Command.cursor = cursor
+
+	return newClose
+}
+
+type cursorStatementImpl struct {
+	jet.SerializerStatement
+
+	Command clauseCursorCommand
+}
+
+type clauseCursorCommand struct {
+	count  *int64
+	cursor string
+}
+
+func (c *clauseCursorCommand) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if c.cursor == "" {
+		panic("jet: cursor name is empty")
+	}
+
+	out.NewLine()
+	out.WriteString(string(statementType))
+
+	if c.count != nil {
+		out.WriteString(strconv.FormatInt(*c.count, 10)) // cursor commands do not accept parametrized arguments
+		out.WriteString("FROM")
+	}
+
+	out.WriteIdentifier(c.cursor)
+}
+
+type cursorDB interface {
+	qrm.Queryable
+	qrm.Executable
+}
+
+// FetchBatches declares the cursor, and fetches cursor rows in batches of batchSize rows until all the rows are
+// fetched. Each batch is mapped into a slice of T, and passed to batchFunc. Cursor is closed afterwards, or when
+// batchFunc returns an error. Unless cursor is declared WITH HOLD, db has to be a transaction.
+//
+// Batch is limited by the number of cursor rows, not by the number of T objects. If T groups rows into nested
+// slices (one-to-many relations, for instance film with its actors), the last object of each batch is held back
+// and passed with the next batch, so that each object is passed to batchFunc once, with all of its rows. Cursor query
+// should be ordered by the T object primary key, otherwise rows of the same object can still be split across batches.
+//
+//	err := FetchBatches(ctx, tx, DECLARE("film_cursor").CURSOR_FOR(stmt), 1000, func(films []model.Film) error {
+//		...
+//	})
+func FetchBatches[T any](ctx context.Context, db cursorDB, cursor DeclareStatement, batchSize int64, batchFunc func(batch []T) error) error {
+	if batchSize <= 0 {
+		panic("jet: cursor batch size has to be greater than 0")
+	}
+
+	cursorName := cursor.cursorName()
+
+	if _, err := cursor.ExecContext(ctx, db); err != nil {
+		return err
+	}
+
+	err := fetchBatches(ctx, db, cursorName, batchSize, batchFunc)
+
+	_, closeErr := CLOSE(cursorName).ExecContext(ctx, db)
+
+	if err != nil {
+		return err
+	}
+
+	return closeErr
+}
+
+func fetchBatches[T any](ctx context.Context, db cursorDB, cursorName string, batchSize int64, batchFunc func(batch []T) error) error {
+	fetchStmt := FETCH(batchSize).FROM(cursorName)
+	groupsRows := destinationGroupsRows(reflect.TypeOf((*T)(nil)).Elem(), map[reflect.Type]bool{})
+
+	var heldBackRows [][]interface{}
+
+	for {
+		var batch []T
+		var rows *batchRows
+
+		err := jet.QueryWith(ctx, fetchStmt, func(ctx context.Context, query string, args []interface{}) (qrm.RowSource, error) {
+			cursorRows, err := db.QueryContext(ctx, query, args...)
+
+			if err != nil {
+				return nil, err
+			}
+
+			rows = &batchRows{
+				RowSource:    cursorRows,
+				heldBack:     heldBackRows,
+				objectsCount: func() int { return len(batch) },
+			}
+
+			return rows, nil
+		}, &batch)
+
+		if err != nil {
+			return err
+		}
+
+		rows.finish()
+
+		if len(rows.rows) == 0 {
+			return nil
+		}
+
+		// batch is grouped into T objects, so cursor is exhausted only if the number of fetched rows is less than batchSize
+		exhausted := rows.fetched < batchSize
+		heldBackRows = nil
+
+		if groupsRows && !exhausted && len(batch) > 0 {
+			// rows of the last object can continue in the next batch
+			heldBackRows = rows.lastObjectRows(len(batch))
+			batch = batch[:len(batch)-1]
+		}
+
+		if len(batch) > 0 {
+			if err := batchFunc(batch); err != nil {
+				return err
+			}
+		}
+
+		if exhausted {
+			return nil
+		}
+	}
+}
+
+// destinationGroupsRows returns true if rows mapped into destination type can be grouped into nested slices
+func destinationGroupsRows(destType reflect.Type, visited map[reflect.Type]bool) bool {
+	for destType.Kind() == reflect.Ptr {
+		destType = destType.Elem()
+	}
+
+	if destType.Kind() != reflect.Struct || destType == timeType || reflect.PtrTo(destType).Implements(scannerType) ||
+		visited[destType] {
+		return false
+	}
+
+	visited[destType] = true
+
+	for i := 0; i < destType.NumField(); i++ {
+		fieldType := destType.Field(i).Type
+
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+
+		if fieldType.Kind() == reflect.Slice {
+			if fieldType.Elem().Kind() != reflect.Uint8 && !reflect.PtrTo(fieldType).Implements(scannerType) {
+				return true
+			}
+
+			continue
+		}
+
+		if destinationGroupsRows(fieldType, visited) {
+			return true
+		}
+	}
+
+	return false
+}
+
+var (
+	timeType    = reflect.TypeOf(time.Time{})
+	scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+)
+
+// batchRows is the source of cursor batch rows. Rows held back from the previous batch are read first, followed by the
+// rows fetched from the cursor. Values of all the rows read are recorded, along with the number of batch objects mapped
+// after each row.
+type batchRows struct {
+	qrm.RowSource
+
+	heldBack     [][]interface{}
+	objectsCount func() int
+
+	rows    []batchRow
+	fetched int64
+	current []interface{} // held back row currently read, nil if row is read from the cursor
+}
+
+type batchRow struct {
+	values       []interface{}
+	objectsAfter int
+}
+
+func (b *batchRows) Next() bool {
+	b.finish()
+
+	if len(b.heldBack) > 0 {
+		b.current = b.heldBack[0]
+		b.heldBack = b.heldBack[1:]
+		b.rows = append(b.rows, batchRow{values: b.current, objectsAfter: -1})
+		return true
+	}
+
+	b.current = nil
+
+	if !b.RowSource.Next() {
+		return false
+	}
+
+	b.fetched++
+	b.rows = append(b.rows, batchRow{objectsAfter: -1})
+
+	return true
+}
+
+func (b *batchRows) Scan(dest ...interface{}) error {
+	row := &b.rows[len(b.rows)-1]
+
+	if b.current != nil {
+		for i, value := range b.current {
+			*dest[i].(*interface{}) = value
+		}
+
+		return nil
+	}
+
+	if err := b.RowSource.Scan(dest...); err != nil {
+		return err
+	}
+
+	row.values = make([]interface{}, len(dest))
+
+	for i := range dest {
+		row.values[i] = *dest[i].(*interface{})
+	}
+
+	return nil
+}
+
+// finish records the number of batch objects mapped after the last row read
+func (b *batchRows) finish() {
+	if len(b.rows) > 0 && b.rows[len(b.rows)-1].objectsAfter < 0 {
+		b.rows[len(b.rows)-1].objectsAfter = b.objectsCount()
+	}
+}
+
+// lastObjectRows returns values of the rows read since the last of objectsCount objects has been mapped
+func (b *batchRows) lastObjectRows(objectsCount int) [][]interface{} {
+	var ret [][]interface{}
+
+	for _, row := range b.rows {
+		if row.objectsAfter == objectsCount {
+			ret = append(ret, row.values)
+		}
+	}
+
+	return ret
+}
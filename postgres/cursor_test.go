@@ -0,0 +1,186 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-jet/jet/v2/qrm/qrmtest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeclareCursor(t *testing.T) {
+	query := SELECT(table1ColInt).FROM(table1).WHERE(table1ColInt.GT(Int(10)))
+
+	assertStatementSql(t, DECLARE("table1_cursor").CURSOR_FOR(query), `
+DECLARE table1_cursor CURSOR FOR
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int > $1;
+`, int64(10))
+
+	assertDebugStatementSql(t, DECLARE("Table1Cursor").CURSOR_FOR(query).NO_SCROLL().WITH_HOLD(), `
+DECLARE "Table1Cursor" NO SCROLL CURSOR WITH HOLD FOR
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int > 10;
+`)
+
+	assertStatementSql(t, DECLARE("table1_cursor").CURSOR_FOR(query).SCROLL(), `
+DECLARE table1_cursor SCROLL CURSOR FOR
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int > $1;
+`, int64(10))
+
+	assertStatementSqlErr(t, DECLARE("table1_cursor").CURSOR_FOR(nil), "jet: cursor query is nil")
+}
+
+func TestCursorCommands(t *testing.T) {
+	assertStatementSql(t, FETCH(100).FROM("table1_cursor"), `
+FETCH 100 FROM table1_cursor;
+`)
+	assertStatementSql(t, FETCH(-1).FROM("table1_cursor"), `
+FETCH -1 FROM table1_cursor;
+`)
+	assertStatementSql(t, MOVE(5).FROM("table1_cursor"), `
+MOVE 5 FROM table1_cursor;
+`)
+	assertStatementSql(t, CLOSE("table1_cursor"), `
+CLOSE table1_cursor;
+`)
+	assertStatementSqlErr(t, CLOSE(""), "jet: cursor name is empty")
+}
+
+type cursorTestRow struct {
+	ColInt int64 `alias:"table1.col_int"`
+}
+
+func TestFetchBatches(t *testing.T) {
+	cursor := DECLARE("table1_cursor").CURSOR_FOR(SELECT(table1ColInt).FROM(table1))
+
+	db := qrmtest.New()
+	db.ExpectStatement(cursor).WillReturnResult(0, 0)
+	db.ExpectStatement(FETCH(2).FROM("table1_cursor")).WillReturnRows(qrmtest.NewRows("table1.col_int").AddRow(1).AddRow(2))
+	db.ExpectStatement(FETCH(2).FROM("table1_cursor")).WillReturnRows(qrmtest.NewRows("table1.col_int").AddRow(3).AddRow(4))
+	db.ExpectStatement(FETCH(2).FROM("table1_cursor")).WillReturnRows(qrmtest.NewRows("table1.col_int").AddRow(5))
+	db.ExpectStatement(CLOSE("table1_cursor")).WillReturnResult(0, 0)
+
+	var batches [][]cursorTestRow
+
+	err := FetchBatches(context.Background(), db, cursor, 2, func(batch []cursorTestRow) error {
+		batches = append(batches, batch)
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.ExpectationsWereMet())
+	require.Equal(t, [][]cursorTestRow{
+		{{ColInt: 1}, {ColInt: 2}},
+		{{ColInt: 3}, {ColInt: 4}},
+		{{ColInt: 5}},
+	}, batches)
+}
+
+type cursorTestParent struct {
+	ColInt   int64 `sql:"primary_key" alias:"table1.col_int"`
+	Children []struct {
+		ColInt int64 `sql:"primary_key" alias:"table2.col_int"`
+	}
+}
+
+func TestFetchBatchesOneToMany(t *testing.T) {
+	cursor := DECLARE("table1_cursor").CURSOR_FOR(
+		SELECT(table1ColInt, table2ColInt).
+			FROM(table1.INNER_JOIN(table2, table2ColInt.EQ(table1ColInt))).
+			ORDER_BY(table1ColInt),
+	)
+
+	fetchStmt := FETCH(3).FROM("table1_cursor")
+
+	db := qrmtest.New()
+	db.ExpectStatement(cursor).WillReturnResult(0, 0)
+	db.ExpectStatement(fetchStmt).WillReturnRows(qrmtest.NewRows("table1.col_int", "table2.col_int").
+		AddRow(1, 10).AddRow(1, 11).AddRow(2, 20))
+	db.ExpectStatement(fetchStmt).WillReturnRows(qrmtest.NewRows("table1.col_int", "table2.col_int").
+		AddRow(2, 21).AddRow(3, 30).AddRow(3, 31))
+	db.ExpectStatement(fetchStmt).WillReturnRows(qrmtest.NewRows("table1.col_int", "table2.col_int"))
+	db.ExpectStatement(CLOSE("table1_cursor")).WillReturnResult(0, 0)
+
+	var parents [][]int64
+	var children [][]int64
+
+	err := FetchBatches(context.Background(), db, cursor, 3, func(batch []cursorTestParent) error {
+		var batchParents, batchChildren []int64
+
+		for _, parent := range batch {
+			batchParents = append(batchParents, parent.ColInt)
+
+			for _, child := range parent.Children {
+				batchChildren = append(batchChildren, child.ColInt)
+			}
+		}
+
+		parents = append(parents, batchParents)
+		children = append(children, batchChildren)
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.ExpectationsWereMet())
+	require.Equal(t, [][]int64{{1}, {2}, {3}}, parents)
+	require.Equal(t, [][]int64{{10, 11}, {20, 21}, {30, 31}}, children)
+}
+
+func TestFetchBatchesObjectSpanningBatches(t *testing.T) {
+	cursor := DECLARE("table1_cursor").CURSOR_FOR(
+		SELECT(table1ColInt, table2ColInt).
+			FROM(table1.INNER_JOIN(table2, table2ColInt.EQ(table1ColInt))).
+			ORDER_BY(table1ColInt),
+	)
+
+	fetchStmt := FETCH(2).FROM("table1_cursor")
+
+	db := qrmtest.New()
+	db.ExpectStatement(cursor).WillReturnResult(0, 0)
+	db.ExpectStatement(fetchStmt).WillReturnRows(qrmtest.NewRows("table1.col_int", "table2.col_int").
+		AddRow(1, 10).AddRow(1, 11))
+	db.ExpectStatement(fetchStmt).WillReturnRows(qrmtest.NewRows("table1.col_int", "table2.col_int").
+		AddRow(1, 12).AddRow(2, 20))
+	db.ExpectStatement(fetchStmt).WillReturnRows(qrmtest.NewRows("table1.col_int", "table2.col_int"))
+	db.ExpectStatement(CLOSE("table1_cursor")).WillReturnResult(0, 0)
+
+	var batches [][]cursorTestParent
+
+	err := FetchBatches(context.Background(), db, cursor, 2, func(batch []cursorTestParent) error {
+		batches = append(batches, batch)
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.ExpectationsWereMet())
+	require.Len(t, batches, 2)
+	require.Len(t, batches[0], 1)
+	require.Equal(t, int64(1), batches[0][0].ColInt)
+	require.Len(t, batches[0][0].Children, 3)
+	require.Len(t, batches[1], 1)
+	require.Equal(t, int64(2), batches[1][0].ColInt)
+	require.Len(t, batches[1][0].Children, 1)
+}
+
+func TestFetchBatchesError(t *testing.T) {
+	cursor := DECLARE("table1_cursor").CURSOR_FOR(SELECT(table1ColInt).FROM(table1))
+	batchErr := errors.New("batch failed")
+
+	db := qrmtest.New()
+	db.ExpectStatement(cursor).WillReturnResult(0, 0)
+	db.ExpectStatement(FETCH(10).FROM("table1_cursor")).WillReturnRows(qrmtest.NewRows("table1.col_int").AddRow(1))
+	db.ExpectStatement(CLOSE("table1_cursor")).WillReturnResult(0, 0)
+
+	err := FetchBatches(context.Background(), db, cursor, 10, func(batch []int64) error {
+		return batchErr
+	})
+	require.ErrorIs(t, err, batchErr)
+	require.NoError(t, db.ExpectationsWereMet())
+
+	assertPanicErr(t, func() {
+		_ = FetchBatches(context.Background(), db, cursor, 0, func(batch []int64) error { return nil })
+	}, "jet: cursor batch size has to be greater than 0")
+}
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// ExplainStatement is a statement showing the execution plan of explained statement
+type ExplainStatement = jet.ExplainStatement
+
+// QueryPlan is statement query plan, parsed from EXPLAIN statement output
+type QueryPlan = jet.QueryPlan
+
+// PlanNode is a node of the statement query plan tree
+type PlanNode = jet.PlanNode
+
+// ExplainOptions are EXPLAIN statement options
+type ExplainOptions struct {
+	// Analyze executes the statement and shows actual run times and row counts. Note that the statement is executed,
+	// so EXPLAIN ANALYZE of INSERT, UPDATE or DELETE statement should be run inside a transaction rolled back afterwards.
+	Analyze bool
+	// Buffers includes information on buffer usage
+	Buffers bool
+	// Verbose displays additional information regarding the plan, like output column list of each node
+	Verbose bool
+}
+
+// EXPLAIN creates new statement showing the execution plan of the statement. Plan is returned in JSON format,
+// and it can be parsed into query plan tree with ExplainStatement QueryPlan method.
+//
+//	plan, err := EXPLAIN(stmt, ExplainOptions{Analyze: true}).QueryPlan(ctx, db)
+func EXPLAIN(statement Statement, options ...ExplainOptions) ExplainStatement {
+	var explainOptions []string
+
+	if len(options) > 0 {
+		if options[0].Analyze {
+			explainOptions = append(explainOptions, "ANALYZE")
+		}
+		if options[0].Buffers {
+			explainOptions = append(explainOptions, "BUFFERS")
+		}
+		if options[0].Verbose {
+			explainOptions = append(explainOptions, "VERBOSE")
+		}
+	}
+
+	explainOptions = append(explainOptions, "FORMAT JSON")
+
+	return jet.NewExplainStatement(Dialect, "EXPLAIN ("+strings.Join(explainOptions, ", ")+")", statement, parseQueryPlan)
+}
+
+type explainPlan struct {
+	NodeType     string        `json:"Node Type"`
+	RelationName string        `json:"Relation Name"`
+	IndexName    string        `json:"Index Name"`
+	IndexCond    string        `json:"Index Cond"`
+	Filter       string        `json:"Filter"`
+	StartupCost  float64       `json:"Startup Cost"`
+	TotalCost    float64       `json:"Total Cost"`
+	PlanRows     float64       `json:"Plan Rows"`
+	ActualRows   *float64      `json:"Actual Rows"`
+	Plans        []explainPlan `json:"Plans"`
+}
+
+func (p explainPlan) planNode() PlanNode {
+	node := PlanNode{
+		NodeType:      p.NodeType,
+		Relation:      p.RelationName,
+		Index:         p.IndexName,
+		Detail:        p.IndexCond,
+		EstimatedRows: p.PlanRows,
+		ActualRows:    p.ActualRows,
+		StartupCost:   p.StartupCost,
+		TotalCost:     p.TotalCost,
+	}
+
+	if node.Detail == "" {
+		node.Detail = p.Filter
+	}
+
+	for _, childPlan := range p.Plans {
+		node.Children = append(node.Children, childPlan.planNode())
+	}
+
+	return node
+}
+
+func parseQueryPlan(rows *sql.Rows) ([]PlanNode, error) {
+	if !rows.Next() {
+		return nil, errors.New("EXPLAIN statement returned no rows")
+	}
+
+	var planJSON []byte
+
+	if err := rows.Scan(&planJSON); err != nil {
+		return nil, err
+	}
+
+	var plans []struct {
+		Plan explainPlan `json:"Plan"`
+	}
+
+	if err := json.Unmarshal(planJSON, &plans); err != nil {
+		return nil, err
+	}
+
+	var nodes []PlanNode
+
+	for _, plan := range plans {
+		nodes = append(nodes, plan.Plan.planNode())
+	}
+
+	return nodes, nil
+}
@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-jet/jet/v2/qrm/qrmtest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplain(t *testing.T) {
+	stmt := SELECT(table1ColInt).FROM(table1).WHERE(table1ColInt.EQ(Int(1)))
+
+	assertStatementSql(t, EXPLAIN(stmt), `
+EXPLAIN (FORMAT JSON)
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int = $1;
+`, int64(1))
+
+	assertDebugStatementSql(t, EXPLAIN(stmt, ExplainOptions{Analyze: true, Buffers: true, Verbose: true}), `
+EXPLAIN (ANALYZE, BUFFERS, VERBOSE, FORMAT JSON)
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int = 1;
+`)
+
+	assertStatementSql(t, EXPLAIN(table1.UPDATE(table1ColInt).SET(Int(2)).WHERE(table1ColInt.EQ(Int(1)))), `
+EXPLAIN (FORMAT JSON)
+UPDATE db.table1
+SET col_int = $1
+WHERE table1.col_int = $2;
+`, int64(2), int64(1))
+}
+
+func TestExplainQueryPlan(t *testing.T) {
+	stmt := EXPLAIN(
+		SELECT(table1ColInt, table2ColInt).
+			FROM(table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))),
+		ExplainOptions{Analyze: true},
+	)
+
+	db := qrmtest.New()
+	db.ExpectStatement(stmt).WillReturnRows(qrmtest.NewRows("QUERY PLAN").AddRow(`[
+  {
+    "Plan": {
+      "Node Type": "Nested Loop",
+      "Startup Cost": 0.28,
+      "Total Cost": 16.5,
+      "Plan Rows": 10,
+      "Actual Rows": 8,
+      "Actual Loops": 1,
+      "Plans": [
+        {
+          "Node Type": "Seq Scan",
+          "Relation Name": "table1",
+          "Alias": "table1",
+          "Startup Cost": 0.0,
+          "Total Cost": 1.1,
+          "Plan Rows": 10,
+          "Actual Rows": 10,
+          "Actual Loops": 1
+        },
+        {
+          "Node Type": "Index Only Scan",
+          "Relation Name": "table2",
+          "Index Name": "table2_col_int_idx",
+          "Index Cond": "(col_int = table1.col_int)",
+          "Startup Cost": 0.28,
+          "Total Cost": 1.5,
+          "Plan Rows": 1,
+          "Actual Rows": 1,
+          "Actual Loops": 10
+        }
+      ]
+    },
+    "Planning Time": 0.1,
+    "Execution Time": 0.2
+  }
+]`))
+
+	plan, err := stmt.QueryPlan(context.Background(), db)
+	require.NoError(t, err)
+	require.NoError(t, db.ExpectationsWereMet())
+
+	require.Len(t, plan.Nodes, 1)
+	require.Equal(t, "Nested Loop", plan.Nodes[0].NodeType)
+	require.Equal(t, 16.5, plan.Nodes[0].TotalCost)
+	require.Equal(t, float64(10), plan.Nodes[0].EstimatedRows)
+	require.Equal(t, float64(8), *plan.Nodes[0].ActualRows)
+	require.Len(t, plan.Nodes[0].Children, 2)
+
+	indexScans := plan.Find(func(node PlanNode) bool {
+		return node.Index != ""
+	})
+	require.Equal(t, []PlanNode{plan.Nodes[0].Children[1]}, indexScans)
+	require.Equal(t, "table2", indexScans[0].Relation)
+	require.Equal(t, "(col_int = table1.col_int)", indexScans[0].Detail)
+	require.True(t, plan.UsesIndex("table2_col_int_idx"))
+	require.False(t, plan.UsesIndex("table1_pkey"))
+}
+
+func TestExplainQueryPlanError(t *testing.T) {
+	stmt := EXPLAIN(SELECT(table1ColInt).FROM(table1))
+
+	db := qrmtest.New()
+	db.ExpectStatement(stmt).WillReturnRows(qrmtest.NewRows("QUERY PLAN").AddRow(`Seq Scan on table1`))
+
+	_, err := stmt.QueryPlan(context.Background(), db)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "jet: failed to parse query plan:")
+}
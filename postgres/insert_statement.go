@@ -1,6 +1,17 @@
 package postgres
 
-import "github.com/go-jet/jet/v2/internal/jet"
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// BeforeInsertHook can be implemented by model types to modify model before it is used by INSERT statement MODEL
+// and MODELS methods. Hook is called on statement execution, on a copy of the model taken by MODEL or MODELS,
+// and error returned from BeforeInsert is returned from the execution. Sql and DebugSql do not call the hook.
+type BeforeInsertHook = jet.BeforeInsertHook
 
 // InsertStatement is interface for SQL INSERT statements
 type InsertStatement interface {
@@ -15,8 +26,24 @@ type InsertStatement interface {
 	QUERY(selectStatement SelectStatement) InsertStatement
 
 	ON_CONFLICT(indexExpressions ...jet.ColumnExpression) onConflict
+	// ON_CONFLICT_PRIMARY_KEY uses table primary key columns as conflict target. If table does not have
+	// primary key columns, this method will panic.
+	ON_CONFLICT_PRIMARY_KEY() onConflict
 
 	RETURNING(projections ...Projection) InsertStatement
+
+	// ExecInBatches executes statement over db connection/transaction as a sequence of statements, each containing
+	// a subset of rows, so that no statement exceeds PostgreSQL limit on the number of parametrized arguments.
+	// Returned result contains the total number of rows affected.
+	// Batches are executed as separate statements, so if db is not a transaction, rows inserted by the batches
+	// executed before a failing batch are not rolled back.
+	ExecInBatches(ctx context.Context, db qrm.Executable) (sql.Result, error)
+	// QueryInBatches executes statement over db connection/transaction as a sequence of statements, each containing
+	// a subset of rows, and appends RETURNING rows of each statement to the destination. Destination has to be
+	// a pointer to a slice.
+	// Batches are executed as separate statements, so if db is not a transaction, rows inserted by the batches
+	// executed before a failing batch are not rolled back.
+	QueryInBatches(ctx context.Context, db qrm.Queryable, destination interface{}) error
 }
 
 func newInsertStatement(table WritableTable, columns []jet.Column) InsertStatement {
@@ -49,12 +76,12 @@ func (i *insertStatementImpl) VALUES(value interface{}, values ...interface{}) I
 }
 
 func (i *insertStatementImpl) MODEL(data interface{}) InsertStatement {
-	i.ValuesQuery.Rows = append(i.ValuesQuery.Rows, jet.UnwindRowFromModel(i.Insert.GetColumns(), data))
+	i.ValuesQuery.Rows = append(i.ValuesQuery.Rows, jet.UnwindRowFromModel(jet.InsertStatementType, i.Insert.GetColumns(), data))
 	return i
 }
 
 func (i *insertStatementImpl) MODELS(data interface{}) InsertStatement {
-	i.ValuesQuery.Rows = append(i.ValuesQuery.Rows, jet.UnwindRowsFromModels(i.Insert.GetColumns(), data)...)
+	i.ValuesQuery.Rows = append(i.ValuesQuery.Rows, jet.UnwindRowsFromModels(jet.InsertStatementType, i.Insert.GetColumns(), data)...)
 	return i
 }
 
@@ -71,7 +98,40 @@ func (i *insertStatementImpl) QUERY(selectStatement SelectStatement) InsertState
 func (i *insertStatementImpl) ON_CONFLICT(indexExpressions ...jet.ColumnExpression) onConflict {
 	i.OnConflict = onConflictClause{
 		insertStatement:  i,
+		insertColumns:    i.Insert.GetColumns(),
 		indexExpressions: indexExpressions,
 	}
 	return &i.OnConflict
 }
+
+func (i *insertStatementImpl) ON_CONFLICT_PRIMARY_KEY() onConflict {
+	primaryKeys := jet.PrimaryKeys(i.Insert.Table)
+
+	if len(primaryKeys) == 0 {
+		panic("jet: ON_CONFLICT_PRIMARY_KEY requires table with primary key columns")
+	}
+
+	return i.ON_CONFLICT(primaryKeys...)
+}
+
+// PostgreSQL wire protocol limits the number of parametrized arguments per statement to 65535.
+const maxArgumentsPerStatement = 65535
+
+func (i *insertStatementImpl) ExecInBatches(ctx context.Context, db qrm.Executable) (sql.Result, error) {
+	return jet.ExecInBatches(ctx, db, Dialect, maxArgumentsPerStatement, i.ValuesQuery.Rows, i.batchStatement)
+}
+
+func (i *insertStatementImpl) QueryInBatches(ctx context.Context, db qrm.Queryable, destination interface{}) error {
+	return jet.QueryInBatches(ctx, db, Dialect, maxArgumentsPerStatement, i.ValuesQuery.Rows, i.batchStatement, destination)
+}
+
+func (i *insertStatementImpl) batchStatement(rows [][]jet.Serializer) Statement {
+	batch := newInsertStatement(nil, nil).(*insertStatementImpl)
+	batch.Insert = i.Insert
+	batch.ValuesQuery = i.ValuesQuery
+	batch.OnConflict = i.OnConflict
+	batch.Returning = i.Returning
+	batch.ValuesQuery.Rows = rows
+
+	return batch
+}
@@ -1,6 +1,9 @@
 package postgres
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
 	"github.com/go-jet/jet/v2/internal/jet"
 	"github.com/stretchr/testify/require"
 	"testing"
@@ -90,6 +93,32 @@ VALUES ($1, $2),
 	assertStatementSql(t, stmt, expectedSQL, 1, float64(1.11), 1, float64(1.11))
 }
 
+func TestInsertValuesFromReusedModel(t *testing.T) {
+	type Table1Model struct {
+		Col1     int
+		ColFloat float64
+	}
+
+	var model Table1Model
+
+	stmt := table1.INSERT(table1Col1, table1ColFloat)
+
+	for i := 1; i <= 3; i++ {
+		model.Col1 = i
+		model.ColFloat = float64(i) + 0.5
+		stmt = stmt.MODEL(&model)
+	}
+
+	model.Col1 = 4
+
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_float)
+VALUES ($1, $2),
+       ($3, $4),
+       ($5, $6);
+`, 1, 1.5, 2, 2.5, 3, 3.5)
+}
+
 func TestInsertValuesFromModelColumnMismatch(t *testing.T) {
 	defer func() {
 		r := recover()
@@ -199,3 +228,73 @@ RETURNING table1.col1 AS "table1.col1",
           table1.col_bool AS "table1.col_bool";
 `)
 }
+
+type execRecorder struct {
+	queries []string
+	args    [][]interface{}
+}
+
+func (e *execRecorder) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	e.queries = append(e.queries, query)
+	e.args = append(e.args, args)
+	return driver.RowsAffected(len(args) / 3), nil
+}
+
+func TestInsert_ExecInBatches(t *testing.T) {
+	type Table1Model struct {
+		Col1     *int
+		ColFloat *float64
+		ColBool  bool
+	}
+
+	var models []Table1Model
+
+	for i := 0; i < 30000; i++ {
+		models = append(models, Table1Model{ColBool: i%2 == 0})
+	}
+
+	stmt := table1.INSERT(table1Col1, table1ColFloat, table1ColBool).
+		MODELS(models).
+		ON_CONFLICT(table1Col1).DO_NOTHING()
+
+	db := &execRecorder{}
+
+	res, err := stmt.ExecInBatches(context.Background(), db)
+	require.NoError(t, err)
+
+	rowsAffected, err := res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(30000), rowsAffected)
+
+	require.Len(t, db.queries, 2)
+	require.Len(t, db.args[0], 65535)
+	require.Len(t, db.args[1], 90000-65535)
+	require.Contains(t, db.queries[0], "ON CONFLICT (col1) DO NOTHING;")
+	require.Contains(t, db.queries[1], "VALUES ($1, $2, $3),")
+	require.Contains(t, db.queries[1], "ON CONFLICT (col1) DO NOTHING;")
+
+	// original statement is not modified
+	_, args := stmt.Sql()
+	require.Len(t, args, 90000)
+}
+
+func TestInsert_ON_CONFLICT_PRIMARY_KEY(t *testing.T) {
+	stmt := table1WithPK.INSERT(table1Col1, table1ColInt, table1ColFloat).
+		VALUES(1, 2, 3.3).
+		ON_CONFLICT_PRIMARY_KEY().DO_UPDATE_ALL()
+
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_int, col_float)
+VALUES ($1, $2, $3)
+ON CONFLICT (col1) DO UPDATE
+       SET col_int = excluded.col_int,
+           col_float = excluded.col_float;
+`, 1, 2, 3.3)
+
+	require.PanicsWithValue(t, "jet: ON_CONFLICT_PRIMARY_KEY requires table with primary key columns", func() {
+		table1.INSERT(table1ColInt).VALUES(1).ON_CONFLICT_PRIMARY_KEY()
+	})
+	require.PanicsWithValue(t, "jet: no columns selected", func() {
+		table1WithPK.INSERT(table1Col1).VALUES(1).ON_CONFLICT_PRIMARY_KEY().DO_UPDATE_ALL()
+	})
+}
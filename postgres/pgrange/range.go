@@ -0,0 +1,258 @@
+// Package pgrange contains model types for PostgreSQL range and multirange columns. Types implement sql.Scanner and
+// driver.Valuer using PostgreSQL range text format, for instance [1,10) or ["2020-01-01 00:00:00+00",).
+package pgrange
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// BoundType is type of the range bound
+type BoundType uint8
+
+// Range bound types
+const (
+	Inclusive BoundType = iota
+	Exclusive
+	Unbounded
+)
+
+// Range is PostgreSQL range of values of the element type T. Lower and Upper values are ignored if the bound type
+// is Unbounded, and all the fields are ignored if range is Empty.
+type Range[T any] struct {
+	Lower      T
+	Upper      T
+	LowerBound BoundType
+	UpperBound BoundType
+	Empty      bool
+}
+
+// elementCodec converts range element values from and to range text format
+type elementCodec[T any] struct {
+	parse  func(text string) (T, error)
+	format func(value T) string
+}
+
+func (r Range[T]) text(codec elementCodec[T]) string {
+	if r.Empty {
+		return "empty"
+	}
+
+	var buf strings.Builder
+
+	if r.LowerBound == Inclusive {
+		buf.WriteByte('[')
+	} else {
+		buf.WriteByte('(')
+	}
+
+	if r.LowerBound != Unbounded {
+		buf.WriteString(quoteElement(codec.format(r.Lower)))
+	}
+
+	buf.WriteByte(',')
+
+	if r.UpperBound != Unbounded {
+		buf.WriteString(quoteElement(codec.format(r.Upper)))
+	}
+
+	if r.UpperBound == Inclusive {
+		buf.WriteByte(']')
+	} else {
+		buf.WriteByte(')')
+	}
+
+	return buf.String()
+}
+
+func parseRange[T any](text string, codec elementCodec[T]) (Range[T], error) {
+	var ret Range[T]
+
+	text = strings.TrimSpace(text)
+
+	if strings.EqualFold(text, "empty") {
+		ret.Empty = true
+		return ret, nil
+	}
+
+	if len(text) < 3 {
+		return ret, fmt.Errorf("invalid range '%s'", text)
+	}
+
+	switch text[0] {
+	case '[':
+		ret.LowerBound = Inclusive
+	case '(':
+		ret.LowerBound = Exclusive
+	default:
+		return ret, fmt.Errorf("invalid range '%s', missing lower bound", text)
+	}
+
+	switch text[len(text)-1] {
+	case ']':
+		ret.UpperBound = Inclusive
+	case ')':
+		ret.UpperBound = Exclusive
+	default:
+		return ret, fmt.Errorf("invalid range '%s', missing upper bound", text)
+	}
+
+	elements, err := splitElements(text[1 : len(text)-1])
+
+	if err != nil {
+		return ret, fmt.Errorf("invalid range '%s': %w", text, err)
+	}
+
+	if len(elements) != 2 {
+		return ret, fmt.Errorf("invalid range '%s', expected two bounds", text)
+	}
+
+	if elements[0] == nil {
+		ret.LowerBound = Unbounded
+	} else if ret.Lower, err = codec.parse(*elements[0]); err != nil {
+		return ret, fmt.Errorf("invalid range '%s' lower bound: %w", text, err)
+	}
+
+	if elements[1] == nil {
+		ret.UpperBound = Unbounded
+	} else if ret.Upper, err = codec.parse(*elements[1]); err != nil {
+		return ret, fmt.Errorf("invalid range '%s' upper bound: %w", text, err)
+	}
+
+	return ret, nil
+}
+
+// splitElements splits comma separated list of range bounds. Missing bound is returned as nil, and quoted
+// bounds are unquoted.
+func splitElements(text string) ([]*string, error) {
+	var elements []*string
+	var element strings.Builder
+
+	quoted, present := false, false
+
+	for i := 0; i < len(text); i++ {
+		c := text[i]
+
+		switch {
+		case c == '\\' && i+1 < len(text):
+			i++
+			element.WriteByte(text[i])
+			present = true
+		case c == '"':
+			if quoted && i+1 < len(text) && text[i+1] == '"' {
+				i++
+				element.WriteByte('"')
+			} else {
+				quoted = !quoted
+			}
+			present = true
+		case c == ',' && !quoted:
+			elements = appendElement(elements, element.String(), present)
+			element.Reset()
+			present = false
+		default:
+			element.WriteByte(c)
+			present = true
+		}
+	}
+
+	if quoted {
+		return nil, errors.New("unterminated quoted bound")
+	}
+
+	return appendElement(elements, element.String(), present), nil
+}
+
+func appendElement(elements []*string, element string, present bool) []*string {
+	if !present {
+		return append(elements, nil)
+	}
+
+	return append(elements, &element)
+}
+
+func quoteElement(element string) string {
+	if element == "" || strings.ContainsAny(element, `,()[]"\ `) {
+		return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(element) + `"`
+	}
+
+	return element
+}
+
+// scanText returns text representation of the database value
+func scanText(value interface{}) (string, bool, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", false, nil
+	case []byte:
+		return string(v), true, nil
+	case string:
+		return v, true, nil
+	}
+
+	return "", false, fmt.Errorf("can't scan range from %T", value)
+}
+
+func scanRange[T any](dest *Range[T], value interface{}, codec elementCodec[T]) error {
+	text, ok, err := scanText(value)
+
+	if err != nil || !ok {
+		*dest = Range[T]{}
+		return err
+	}
+
+	*dest, err = parseRange(text, codec)
+
+	return err
+}
+
+func scanMultiRange[T any](value interface{}, codec elementCodec[T]) ([]Range[T], error) {
+	text, ok, err := scanText(value)
+
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	text = strings.TrimSpace(text)
+
+	if len(text) < 2 || text[0] != '{' || text[len(text)-1] != '}' {
+		return nil, fmt.Errorf("invalid multirange '%s'", text)
+	}
+
+	ranges := []Range[T]{}
+	quoted, start := false, -1
+
+	for i := 1; i < len(text)-1; i++ {
+		switch c := text[i]; {
+		case c == '\\':
+			i++
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == '[' || c == '(':
+			start = i
+		case (c == ']' || c == ')') && start >= 0:
+			rangeValue, err := parseRange(text[start:i+1], codec)
+
+			if err != nil {
+				return nil, err
+			}
+
+			ranges = append(ranges, rangeValue)
+			start = -1
+		}
+	}
+
+	return ranges, nil
+}
+
+func multiRangeText[T any](ranges []Range[T], codec elementCodec[T]) string {
+	texts := make([]string, len(ranges))
+
+	for i, r := range ranges {
+		texts[i] = r.text(codec)
+	}
+
+	return "{" + strings.Join(texts, ",") + "}"
+}
@@ -0,0 +1,123 @@
+package pgrange
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInt4Range(t *testing.T) {
+	var r Int4Range
+
+	require.NoError(t, r.Scan([]byte("[1,10)")))
+	require.Equal(t, Int4Range{Lower: 1, Upper: 10, LowerBound: Inclusive, UpperBound: Exclusive}, r)
+
+	value, err := r.Value()
+	require.NoError(t, err)
+	require.Equal(t, "[1,10)", value)
+
+	require.NoError(t, r.Scan("(,5]"))
+	require.Equal(t, Int4Range{Upper: 5, LowerBound: Unbounded, UpperBound: Inclusive}, r)
+
+	value, _ = r.Value()
+	require.Equal(t, "(,5]", value)
+
+	require.NoError(t, r.Scan("empty"))
+	require.Equal(t, Int4Range{Empty: true}, r)
+
+	value, _ = r.Value()
+	require.Equal(t, "empty", value)
+
+	require.NoError(t, r.Scan(nil))
+	require.Equal(t, Int4Range{}, r)
+
+	require.EqualError(t, r.Scan("[a,2)"), `invalid range '[a,2)' lower bound: strconv.ParseInt: parsing "a": invalid syntax`)
+	require.EqualError(t, r.Scan("1,2"), "invalid range '1,2', missing lower bound")
+	require.EqualError(t, r.Scan(int64(1)), "can't scan range from int64")
+}
+
+func TestNumRange(t *testing.T) {
+	var r NumRange
+
+	require.NoError(t, r.Scan("(1.5,2.25]"))
+	require.Equal(t, NumRange{Lower: 1.5, Upper: 2.25, LowerBound: Exclusive, UpperBound: Inclusive}, r)
+
+	value, _ := r.Value()
+	require.Equal(t, "(1.5,2.25]", value)
+}
+
+func TestTsTzRange(t *testing.T) {
+	var r TsTzRange
+
+	require.NoError(t, r.Scan(`["2020-01-01 10:00:00+00","2020-01-02 10:30:00.5+02:00")`))
+
+	require.Equal(t, Inclusive, r.LowerBound)
+	require.Equal(t, Exclusive, r.UpperBound)
+	require.True(t, r.Lower.Equal(time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)))
+	require.True(t, r.Upper.Equal(time.Date(2020, 1, 2, 8, 30, 0, 500000000, time.UTC)))
+
+	require.NoError(t, r.Scan(`["2020-01-01 10:00:00Z",)`))
+	require.Equal(t, Unbounded, r.UpperBound)
+
+	value, err := TsTzRange{
+		Lower:      time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC),
+		UpperBound: Unbounded,
+	}.Value()
+
+	require.NoError(t, err)
+	require.Equal(t, `["2020-01-01 10:00:00Z",)`, value)
+}
+
+func TestDateRange(t *testing.T) {
+	var r DateRange
+
+	require.NoError(t, r.Scan("[2020-01-01,2020-02-01)"))
+	require.Equal(t, DateRange{
+		Lower:      time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		Upper:      time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC),
+		UpperBound: Exclusive,
+	}, r)
+
+	value, _ := r.Value()
+	require.Equal(t, "[2020-01-01,2020-02-01)", value)
+
+	require.NoError(t, r.Scan("[-infinity,infinity)"))
+	require.Equal(t, DateRange{Lower: TimeNegativeInfinity, Upper: TimeInfinity, UpperBound: Exclusive}, r)
+
+	value, _ = r.Value()
+	require.Equal(t, "[-infinity,infinity)", value)
+
+	var tsTzRange TsTzRange
+
+	require.NoError(t, tsTzRange.Scan(`["2020-01-01 10:00:00+00",infinity]`))
+	require.Equal(t, TimeInfinity, tsTzRange.Upper)
+
+	value, _ = tsTzRange.Value()
+	require.Equal(t, `["2020-01-01 10:00:00Z",infinity]`, value)
+}
+
+func TestMultiRange(t *testing.T) {
+	var m Int4MultiRange
+
+	require.NoError(t, m.Scan("{[1,3),[5,7)}"))
+	require.Equal(t, Int4MultiRange{
+		{Lower: 1, Upper: 3, UpperBound: Exclusive},
+		{Lower: 5, Upper: 7, UpperBound: Exclusive},
+	}, m)
+
+	value, _ := m.Value()
+	require.Equal(t, "{[1,3),[5,7)}", value)
+
+	require.NoError(t, m.Scan("{}"))
+	require.Equal(t, Int4MultiRange{}, m)
+
+	var tsm TsMultiRange
+
+	require.NoError(t, tsm.Scan(`{["2020-01-01 10:00:00","2020-01-01 11:00:00"),["2020-01-01 12:00:00",)}`))
+	require.Len(t, tsm, 2)
+	require.Equal(t, Unbounded, tsm[1].UpperBound)
+	require.Equal(t, time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC), tsm[1].Lower)
+
+	require.EqualError(t, m.Scan("[1,3)"), "invalid multirange '[1,3)'")
+}
@@ -0,0 +1,251 @@
+package pgrange
+
+import (
+	"database/sql/driver"
+	"errors"
+	"strconv"
+	"time"
+)
+
+// Int4Range is model type for int4range columns
+type Int4Range Range[int32]
+
+// Scan implements the sql.Scanner interface
+func (r *Int4Range) Scan(value interface{}) error {
+	return scanRange((*Range[int32])(r), value, int4Codec)
+}
+
+// Value implements the driver.Valuer interface
+func (r Int4Range) Value() (driver.Value, error) {
+	return Range[int32](r).text(int4Codec), nil
+}
+
+// Int8Range is model type for int8range columns
+type Int8Range Range[int64]
+
+// Scan implements the sql.Scanner interface
+func (r *Int8Range) Scan(value interface{}) error {
+	return scanRange((*Range[int64])(r), value, int8Codec)
+}
+
+// Value implements the driver.Valuer interface
+func (r Int8Range) Value() (driver.Value, error) {
+	return Range[int64](r).text(int8Codec), nil
+}
+
+// NumRange is model type for numrange columns
+type NumRange Range[float64]
+
+// Scan implements the sql.Scanner interface
+func (r *NumRange) Scan(value interface{}) error {
+	return scanRange((*Range[float64])(r), value, numCodec)
+}
+
+// Value implements the driver.Valuer interface
+func (r NumRange) Value() (driver.Value, error) {
+	return Range[float64](r).text(numCodec), nil
+}
+
+// TsRange is model type for tsrange (timestamp without time zone range) columns
+type TsRange Range[time.Time]
+
+// Scan implements the sql.Scanner interface
+func (r *TsRange) Scan(value interface{}) error {
+	return scanRange((*Range[time.Time])(r), value, tsCodec)
+}
+
+// Value implements the driver.Valuer interface
+func (r TsRange) Value() (driver.Value, error) {
+	return Range[time.Time](r).text(tsCodec), nil
+}
+
+// TsTzRange is model type for tstzrange (timestamp with time zone range) columns
+type TsTzRange Range[time.Time]
+
+// Scan implements the sql.Scanner interface
+func (r *TsTzRange) Scan(value interface{}) error {
+	return scanRange((*Range[time.Time])(r), value, tsTzCodec)
+}
+
+// Value implements the driver.Valuer interface
+func (r TsTzRange) Value() (driver.Value, error) {
+	return Range[time.Time](r).text(tsTzCodec), nil
+}
+
+// DateRange is model type for daterange columns
+type DateRange Range[time.Time]
+
+// Scan implements the sql.Scanner interface
+func (r *DateRange) Scan(value interface{}) error {
+	return scanRange((*Range[time.Time])(r), value, dateCodec)
+}
+
+// Value implements the driver.Valuer interface
+func (r DateRange) Value() (driver.Value, error) {
+	return Range[time.Time](r).text(dateCodec), nil
+}
+
+//------------------------- Multiranges ---------------------------//
+
+// Int4MultiRange is model type for int4multirange columns
+type Int4MultiRange []Range[int32]
+
+// Scan implements the sql.Scanner interface
+func (m *Int4MultiRange) Scan(value interface{}) (err error) {
+	*m, err = scanMultiRange(value, int4Codec)
+	return err
+}
+
+// Value implements the driver.Valuer interface
+func (m Int4MultiRange) Value() (driver.Value, error) {
+	return multiRangeText(m, int4Codec), nil
+}
+
+// Int8MultiRange is model type for int8multirange columns
+type Int8MultiRange []Range[int64]
+
+// Scan implements the sql.Scanner interface
+func (m *Int8MultiRange) Scan(value interface{}) (err error) {
+	*m, err = scanMultiRange(value, int8Codec)
+	return err
+}
+
+// Value implements the driver.Valuer interface
+func (m Int8MultiRange) Value() (driver.Value, error) {
+	return multiRangeText(m, int8Codec), nil
+}
+
+// NumMultiRange is model type for nummultirange columns
+type NumMultiRange []Range[float64]
+
+// Scan implements the sql.Scanner interface
+func (m *NumMultiRange) Scan(value interface{}) (err error) {
+	*m, err = scanMultiRange(value, numCodec)
+	return err
+}
+
+// Value implements the driver.Valuer interface
+func (m NumMultiRange) Value() (driver.Value, error) {
+	return multiRangeText(m, numCodec), nil
+}
+
+// TsMultiRange is model type for tsmultirange columns
+type TsMultiRange []Range[time.Time]
+
+// Scan implements the sql.Scanner interface
+func (m *TsMultiRange) Scan(value interface{}) (err error) {
+	*m, err = scanMultiRange(value, tsCodec)
+	return err
+}
+
+// Value implements the driver.Valuer interface
+func (m TsMultiRange) Value() (driver.Value, error) {
+	return multiRangeText(m, tsCodec), nil
+}
+
+// TsTzMultiRange is model type for tstzmultirange columns
+type TsTzMultiRange []Range[time.Time]
+
+// Scan implements the sql.Scanner interface
+func (m *TsTzMultiRange) Scan(value interface{}) (err error) {
+	*m, err = scanMultiRange(value, tsTzCodec)
+	return err
+}
+
+// Value implements the driver.Valuer interface
+func (m TsTzMultiRange) Value() (driver.Value, error) {
+	return multiRangeText(m, tsTzCodec), nil
+}
+
+// DateMultiRange is model type for datemultirange columns
+type DateMultiRange []Range[time.Time]
+
+// Scan implements the sql.Scanner interface
+func (m *DateMultiRange) Scan(value interface{}) (err error) {
+	*m, err = scanMultiRange(value, dateCodec)
+	return err
+}
+
+// Value implements the driver.Valuer interface
+func (m DateMultiRange) Value() (driver.Value, error) {
+	return multiRangeText(m, dateCodec), nil
+}
+
+//------------------------- Element codecs ---------------------------//
+
+var (
+	int4Codec = elementCodec[int32]{
+		parse: func(text string) (int32, error) {
+			value, err := strconv.ParseInt(text, 10, 32)
+			return int32(value), err
+		},
+		format: func(value int32) string {
+			return strconv.FormatInt(int64(value), 10)
+		},
+	}
+
+	int8Codec = elementCodec[int64]{
+		parse: func(text string) (int64, error) {
+			return strconv.ParseInt(text, 10, 64)
+		},
+		format: func(value int64) string {
+			return strconv.FormatInt(value, 10)
+		},
+	}
+
+	numCodec = elementCodec[float64]{
+		parse: func(text string) (float64, error) {
+			return strconv.ParseFloat(text, 64)
+		},
+		format: func(value float64) string {
+			return strconv.FormatFloat(value, 'f', -1, 64)
+		},
+	}
+
+	tsCodec = timeCodec("2006-01-02 15:04:05.999999",
+		"2006-01-02 15:04:05.999999999", "2006-01-02T15:04:05.999999999")
+
+	tsTzCodec = timeCodec("2006-01-02 15:04:05.999999Z07:00",
+		"2006-01-02 15:04:05.999999999Z07:00:00", "2006-01-02 15:04:05.999999999Z07:00", "2006-01-02 15:04:05.999999999Z07",
+		time.RFC3339Nano)
+
+	dateCodec = timeCodec("2006-01-02", "2006-01-02")
+)
+
+// Time values of PostgreSQL 'infinity' and '-infinity' time range bounds. Infinity is later, and negative infinity
+// is earlier than any time value PostgreSQL can store.
+var (
+	TimeInfinity         = time.Date(294277, time.January, 1, 0, 0, 0, 0, time.UTC)
+	TimeNegativeInfinity = time.Date(-4714, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func timeCodec(formatLayout string, parseLayouts ...string) elementCodec[time.Time] {
+	return elementCodec[time.Time]{
+		parse: func(text string) (time.Time, error) {
+			switch text {
+			case "infinity":
+				return TimeInfinity, nil
+			case "-infinity":
+				return TimeNegativeInfinity, nil
+			}
+
+			for _, layout := range parseLayouts {
+				if value, err := time.Parse(layout, text); err == nil {
+					return value, nil
+				}
+			}
+
+			return time.Time{}, errors.New("unsupported time format '" + text + "'")
+		},
+		format: func(value time.Time) string {
+			switch {
+			case value.Equal(TimeInfinity):
+				return "infinity"
+			case value.Equal(TimeNegativeInfinity):
+				return "-infinity"
+			}
+
+			return value.Format(formatLayout)
+		},
+	}
+}
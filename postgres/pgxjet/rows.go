@@ -0,0 +1,189 @@
+package pgxjet
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/google/uuid"
+	"github.com/jackc/pgproto3/v2"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+)
+
+var connInfo = pgtype.NewConnInfo()
+
+// rowSource adapts pgx.Rows to qrm.RowSource. Column values are read with pgx.Rows Values method, and typed pgx values
+// (numeric, intervals, arrays, UUID, ...) are converted into the values database/sql driver would return,
+// so that query result mapping can scan them into the same destination types. JSON values are read undecoded,
+// with pgx.Rows RawValues method.
+type rowSource struct {
+	pgx.Rows
+}
+
+// NewRowSource creates qrm.RowSource from pgx.Rows, so that pgx result rows can be mapped with qrm.ScanRows.
+func NewRowSource(rows pgx.Rows) qrm.RowSource {
+	return &rowSource{Rows: rows}
+}
+
+func (r *rowSource) Columns() ([]string, error) {
+	fields := r.FieldDescriptions()
+	columns := make([]string, len(fields))
+
+	for i, field := range fields {
+		columns[i] = string(field.Name)
+	}
+
+	return columns, nil
+}
+
+func (r *rowSource) Scan(dest ...interface{}) error {
+	for _, d := range dest {
+		if _, ok := d.(*interface{}); !ok {
+			return r.Rows.Scan(dest...)
+		}
+	}
+
+	values, err := r.Values()
+
+	if err != nil {
+		return err
+	}
+
+	if len(values) != len(dest) {
+		return fmt.Errorf("expected %d destination arguments in Scan, not %d", len(values), len(dest))
+	}
+
+	fields := r.FieldDescriptions()
+	var rawValues [][]byte
+
+	for i, value := range values {
+		var converted interface{}
+		var err error
+
+		if isJSONField(fields[i]) {
+			if rawValues == nil {
+				rawValues = r.RawValues()
+			}
+
+			converted, err = jsonValue(fields[i], rawValues[i])
+		} else {
+			converted, err = convertValue(fields[i].DataTypeOID, value)
+		}
+
+		if err != nil {
+			return fmt.Errorf("column '%s': %w", string(fields[i].Name), err)
+		}
+
+		*dest[i].(*interface{}) = converted
+	}
+
+	return nil
+}
+
+func (r *rowSource) Close() error {
+	r.Rows.Close()
+	return nil
+}
+
+// convertValue converts pgx column value into the value query result mapping can scan into destination. Values pgx
+// already decodes into Go types (bool, integers, floats, string, []byte, time.Time) are returned as they are, and
+// one-dimensional arrays are returned as Go slices. UUIDs are returned as strings, the same as with database/sql
+// driver. Text representation is used only for the types without Go counterpart, like numeric, interval or
+// multidimensional arrays.
+func convertValue(dataTypeOID uint32, value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	if dataTypeOID == pgtype.UUIDOID {
+		if uuidBytes, ok := value.([16]byte); ok {
+			return uuid.UUID(uuidBytes).String(), nil
+		}
+	}
+
+	switch value.(type) {
+	case bool, int16, int32, int64, float32, float64, string, []byte, time.Time:
+		return value, nil
+	}
+
+	if slice, ok := arrayToSlice(value); ok {
+		return slice, nil
+	}
+
+	if textEncoder, ok := value.(pgtype.TextEncoder); ok {
+		text, err := textEncoder.EncodeText(connInfo, nil)
+
+		if err != nil || text == nil {
+			return nil, err
+		}
+
+		return text, nil
+	}
+
+	return value, nil
+}
+
+func isJSONField(field pgproto3.FieldDescription) bool {
+	return field.DataTypeOID == pgtype.JSONOID || field.DataTypeOID == pgtype.JSONBOID
+}
+
+// jsonValue returns JSON document as []byte, exactly as it is received from the server, the same as with database/sql
+// driver. Document is not decoded by pgx, so that numbers precision, object keys order and whitespaces are preserved.
+func jsonValue(field pgproto3.FieldDescription, raw []byte) (interface{}, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	// jsonb binary format is the text of the document prefixed with the format version
+	if field.DataTypeOID == pgtype.JSONBOID && field.Format == pgtype.BinaryFormatCode {
+		if len(raw) == 0 || raw[0] != 1 {
+			return nil, fmt.Errorf("unsupported jsonb binary format version")
+		}
+
+		raw = raw[1:]
+	}
+
+	// raw values are valid only until the next row is read
+	return append([]byte{}, raw...), nil
+}
+
+// arrayToSlice converts pgx one-dimensional array value into Go slice. Arrays with NULL elements, multidimensional
+// arrays and arrays of the types without Go counterpart are not converted.
+func arrayToSlice(value interface{}) (interface{}, bool) {
+	var slicePtr interface{}
+
+	switch value.(type) {
+	case pgtype.BoolArray:
+		slicePtr = &[]bool{}
+	case pgtype.Int2Array:
+		slicePtr = &[]int16{}
+	case pgtype.Int4Array:
+		slicePtr = &[]int32{}
+	case pgtype.Int8Array:
+		slicePtr = &[]int64{}
+	case pgtype.Float4Array:
+		slicePtr = &[]float32{}
+	case pgtype.Float8Array:
+		slicePtr = &[]float64{}
+	case pgtype.TextArray, pgtype.VarcharArray, pgtype.BPCharArray:
+		slicePtr = &[]string{}
+	case pgtype.ByteaArray:
+		slicePtr = &[][]byte{}
+	case pgtype.DateArray, pgtype.TimestampArray, pgtype.TimestamptzArray:
+		slicePtr = &[]time.Time{}
+	default:
+		return nil, false
+	}
+
+	// array AssignTo methods have pointer receivers
+	arrayPtr := reflect.New(reflect.TypeOf(value))
+	arrayPtr.Elem().Set(reflect.ValueOf(value))
+
+	if err := arrayPtr.Interface().(pgtype.Value).AssignTo(slicePtr); err != nil {
+		return nil, false
+	}
+
+	return reflect.ValueOf(slicePtr).Elem().Interface(), true
+}
@@ -0,0 +1,88 @@
+// Package pgxjet executes jet postgres statements over pgx connections, pools and transactions, without the
+// database/sql stdlib shim. Query result rows are mapped into destination with the same rules as Statement.Query.
+package pgxjet
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+func init() {
+	jet.AddExecutorPackage(reflect.TypeOf(Rows{}).PkgPath())
+}
+
+// Queryable interface for pgx query execution. It is implemented by *pgx.Conn, *pgxpool.Pool and pgx.Tx.
+type Queryable interface {
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
+// Executable interface for pgx statement execution. It is implemented by *pgx.Conn, *pgxpool.Pool and pgx.Tx.
+type Executable interface {
+	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
+}
+
+// Query executes statement over pgx connection, pool or transaction db, and stores row results in destination.
+// Destination can be either pointer to struct or pointer to a slice, the same as for Statement.Query.
+func Query(ctx context.Context, statement postgres.Statement, db Queryable, destination interface{}) error {
+	return jet.QueryWith(ctx, statement, queryFunc(db), destination)
+}
+
+// Exec executes statement over pgx connection, pool or transaction db, and returns pgx command tag.
+func Exec(ctx context.Context, statement postgres.Statement, db Executable) (pgconn.CommandTag, error) {
+	var commandTag pgconn.CommandTag
+
+	err := jet.ExecWith(ctx, statement, func(ctx context.Context, query string, args []interface{}) (int64, error) {
+		var err error
+		commandTag, err = db.Exec(ctx, query, args...)
+
+		return commandTag.RowsAffected(), err
+	})
+
+	return commandTag, err
+}
+
+// Rows wraps pgx result rows, and allows mapping of each row into destination with Scan
+type Rows struct {
+	pgx.Rows
+
+	source      qrm.RowSource
+	scanContext *qrm.ScanContext
+}
+
+// Scan will map the Row values into struct destination
+func (r *Rows) Scan(destination interface{}) error {
+	return qrm.ScanOneRowToDest(r.scanContext, r.source, destination)
+}
+
+// QueryRows executes statement over pgx connection, pool or transaction db, and returns Rows
+func QueryRows(ctx context.Context, statement postgres.Statement, db Queryable) (*Rows, error) {
+	source, scanContext, err := jet.RowsWith(ctx, statement, queryFunc(db))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Rows{
+		Rows:        source.(*rowSource).Rows,
+		source:      source,
+		scanContext: scanContext,
+	}, nil
+}
+
+func queryFunc(db Queryable) jet.QueryFunc {
+	return func(ctx context.Context, query string, args []interface{}) (qrm.RowSource, error) {
+		rows, err := db.Query(ctx, query, args...)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return NewRowSource(rows), nil
+	}
+}
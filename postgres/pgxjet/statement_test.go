@@ -0,0 +1,264 @@
+package pgxjet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgproto3/v2"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRows is pgx.Rows implementation returning predefined typed values
+type fakeRows struct {
+	fields []pgproto3.FieldDescription
+	rows   [][]interface{}
+	raw    [][][]byte
+	index  int
+	closed bool
+}
+
+func (f *fakeRows) Close()                                         { f.closed = true }
+func (f *fakeRows) Err() error                                     { return nil }
+func (f *fakeRows) CommandTag() pgconn.CommandTag                  { return pgconn.CommandTag("SELECT") }
+func (f *fakeRows) FieldDescriptions() []pgproto3.FieldDescription { return f.fields }
+func (f *fakeRows) Scan(dest ...interface{}) error                 { panic("unexpected Scan call") }
+
+func (f *fakeRows) Next() bool {
+	f.index++
+	return f.index <= len(f.rows)
+}
+
+func (f *fakeRows) Values() ([]interface{}, error) {
+	return f.rows[f.index-1], nil
+}
+
+func (f *fakeRows) RawValues() [][]byte {
+	if f.raw == nil {
+		return make([][]byte, len(f.fields))
+	}
+
+	return f.raw[f.index-1]
+}
+
+type fakeDB struct {
+	rows  *fakeRows
+	query string
+	args  []interface{}
+}
+
+func (f *fakeDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	f.query, f.args = sql, args
+	return f.rows, nil
+}
+
+func (f *fakeDB) Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error) {
+	f.query, f.args = sql, arguments
+	return pgconn.CommandTag("UPDATE 3"), nil
+}
+
+var (
+	film        = postgres.NewTable("public", "film", "", filmID, filmTitle, filmRate, filmLength, filmTags, filmMeta, filmUUID, filmUpdated)
+	filmID      = postgres.IntegerColumn("film_id")
+	filmTitle   = postgres.StringColumn("title")
+	filmRate    = postgres.FloatColumn("rental_rate")
+	filmLength  = postgres.IntervalColumn("length")
+	filmTags    = postgres.StringColumn("tags")
+	filmMeta    = postgres.StringColumn("meta")
+	filmUUID    = postgres.StringColumn("uuid")
+	filmUpdated = postgres.TimestampzColumn("updated")
+
+	allColumns = postgres.ColumnList{filmID, filmTitle, filmRate, filmLength, filmTags, filmMeta, filmUUID, filmUpdated}
+)
+
+type Film struct {
+	FilmID     int32 `sql:"primary_key"`
+	Title      string
+	RentalRate float64
+	Length     string
+	Tags       []string               `sql:"array"`
+	Meta       map[string]interface{} `sql:"json"`
+	UUID       string
+	Updated    *time.Time
+}
+
+func newFakeDB(t *testing.T) *fakeDB {
+	var rate pgtype.Numeric
+	require.NoError(t, rate.Set("4.99"))
+
+	length := pgtype.Interval{Microseconds: int64(90 * time.Minute / time.Microsecond), Status: pgtype.Present}
+
+	var tags pgtype.TextArray
+	require.NoError(t, tags.Set([]string{"drama", "sci-fi"}))
+
+	updated := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	return &fakeDB{
+		rows: &fakeRows{
+			fields: []pgproto3.FieldDescription{
+				{Name: []byte("film.film_id"), DataTypeOID: pgtype.Int4OID},
+				{Name: []byte("film.title"), DataTypeOID: pgtype.TextOID},
+				{Name: []byte("film.rental_rate"), DataTypeOID: pgtype.NumericOID},
+				{Name: []byte("film.length"), DataTypeOID: pgtype.IntervalOID},
+				{Name: []byte("film.tags"), DataTypeOID: pgtype.TextArrayOID},
+				{Name: []byte("film.meta"), DataTypeOID: pgtype.JSONBOID, Format: pgtype.BinaryFormatCode},
+				{Name: []byte("film.uuid"), DataTypeOID: pgtype.UUIDOID},
+				{Name: []byte("film.updated"), DataTypeOID: pgtype.TimestamptzOID},
+			},
+			rows: [][]interface{}{
+				{int32(1), "Alien", rate, length, tags, map[string]interface{}{"rating": "R"},
+					[16]byte{0x8a, 0x1e, 0x7c, 0x1b, 0x1c, 0x3f, 0x4e, 0x55, 0x9d, 0x2e, 0x0f, 0x4c, 0x47, 0x2b, 0x77, 0x01}, updated},
+				{int32(2), "Brazil", rate, length, nil, nil, nil, nil},
+			},
+			raw: [][][]byte{
+				{nil, nil, nil, nil, nil, append([]byte{1}, `{"rating": "R"}`...), nil, nil},
+				make([][]byte, 8),
+			},
+		},
+	}
+}
+
+func TestQuery(t *testing.T) {
+	db := newFakeDB(t)
+
+	stmt := postgres.SELECT(allColumns).
+		FROM(film).
+		WHERE(filmID.GT(postgres.Int(0)))
+
+	var dest []Film
+
+	err := Query(context.Background(), stmt, db, &dest)
+
+	require.NoError(t, err)
+	require.True(t, db.rows.closed)
+	require.Equal(t, []interface{}{int64(0)}, db.args)
+
+	updated := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	require.Equal(t, []Film{
+		{
+			FilmID:     1,
+			Title:      "Alien",
+			RentalRate: 4.99,
+			Length:     "01:30:00.000000",
+			Tags:       []string{"drama", "sci-fi"},
+			Meta:       map[string]interface{}{"rating": "R"},
+			UUID:       "8a1e7c1b-1c3f-4e55-9d2e-0f4c472b7701",
+			Updated:    &updated,
+		},
+		{
+			FilmID:     2,
+			Title:      "Brazil",
+			RentalRate: 4.99,
+			Length:     "01:30:00.000000",
+		},
+	}, dest)
+}
+
+func TestQueryRows(t *testing.T) {
+	db := newFakeDB(t)
+
+	rows, err := QueryRows(context.Background(), postgres.SELECT(allColumns).FROM(film), db)
+	require.NoError(t, err)
+
+	var titles []string
+
+	for rows.Next() {
+		var dest Film
+		require.NoError(t, rows.Scan(&dest))
+		titles = append(titles, dest.Title)
+	}
+
+	rows.Close()
+	require.NoError(t, rows.Err())
+	require.Equal(t, []string{"Alien", "Brazil"}, titles)
+}
+
+func TestExec(t *testing.T) {
+	db := newFakeDB(t)
+
+	stmt := film.UPDATE(filmTitle).SET(postgres.String("Alien")).WHERE(filmID.EQ(postgres.Int(1)))
+
+	commandTag, err := Exec(context.Background(), stmt, db)
+
+	require.NoError(t, err)
+	require.Equal(t, int64(3), commandTag.RowsAffected())
+	require.Equal(t, "\nUPDATE public.film\nSET title = $1::text\nWHERE film.film_id = $2;\n", db.query)
+	require.Equal(t, []interface{}{"Alien", int64(1)}, db.args)
+}
+
+func TestQueryNativeArrays(t *testing.T) {
+	var ratings pgtype.Int4Array
+	require.NoError(t, ratings.Set([]int32{4, 5}))
+
+	var matrix pgtype.Int4Array
+	require.NoError(t, matrix.Set([][]int32{{1, 2}, {3, 4}}))
+
+	db := &fakeDB{
+		rows: &fakeRows{
+			fields: []pgproto3.FieldDescription{
+				{Name: []byte("film_id"), DataTypeOID: pgtype.Int4OID},
+				{Name: []byte("ratings"), DataTypeOID: pgtype.Int4ArrayOID},
+				{Name: []byte("scores"), DataTypeOID: pgtype.Int4ArrayOID},
+				{Name: []byte("matrix"), DataTypeOID: pgtype.Int4ArrayOID},
+			},
+			rows: [][]interface{}{{int32(1), ratings, ratings, matrix}},
+		},
+	}
+
+	var dest struct {
+		FilmID  int32 `sql:"primary_key"`
+		Ratings pq.Int32Array
+		Scores  []int64   `sql:"array"`
+		Matrix  [][]int32 `sql:"array"`
+	}
+
+	err := Query(context.Background(), postgres.SELECT(filmID).FROM(film), db, &dest)
+
+	require.NoError(t, err)
+	require.Equal(t, pq.Int32Array{4, 5}, dest.Ratings)
+	require.Equal(t, []int64{4, 5}, dest.Scores)
+	require.Equal(t, [][]int32{{1, 2}, {3, 4}}, dest.Matrix)
+}
+
+func TestQueryJSON(t *testing.T) {
+	document := `{"b": 1.10000000000000000001, "a": [1, 2]}`
+
+	db := &fakeDB{
+		rows: &fakeRows{
+			fields: []pgproto3.FieldDescription{
+				{Name: []byte("film.film_id"), DataTypeOID: pgtype.Int4OID},
+				{Name: []byte("film.meta"), DataTypeOID: pgtype.JSONOID, Format: pgtype.TextFormatCode},
+				{Name: []byte("film.meta_binary"), DataTypeOID: pgtype.JSONBOID, Format: pgtype.BinaryFormatCode},
+			},
+			rows: [][]interface{}{
+				{int32(1), map[string]interface{}{}, map[string]interface{}{}},
+			},
+			raw: [][][]byte{
+				{nil, []byte(document), append([]byte{1}, document...)},
+			},
+		},
+	}
+
+	var dest struct {
+		FilmID     int32  `sql:"primary_key" alias:"film.film_id"`
+		Meta       string `alias:"film.meta"`
+		MetaBinary []byte `alias:"film.meta_binary"`
+	}
+
+	err := Query(context.Background(), postgres.SELECT(filmID, filmMeta).FROM(film), db, &dest)
+	require.NoError(t, err)
+	require.Equal(t, document, dest.Meta)
+	require.Equal(t, []byte(document), dest.MetaBinary)
+
+	db.rows.index = 0
+	db.rows.raw[0][2] = []byte{2}
+
+	err = Query(context.Background(), postgres.SELECT(filmID, filmMeta).FROM(film), db, &dest)
+	require.EqualError(t, err, "jet: column 'film.meta_binary': unsupported jsonb binary format version")
+}
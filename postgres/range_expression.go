@@ -0,0 +1,358 @@
+package postgres
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// rangeExpression is interface of operators and functions common to postgres ranges and multiranges of element
+// expressions of type T. S is the expression type of the range (or multirange) itself.
+type rangeExpression[T Expression, S Expression] interface {
+	jet.Expression
+
+	isRange(T)
+
+	EQ(rhs S) BoolExpression
+	NOT_EQ(rhs S) BoolExpression
+	LT(rhs S) BoolExpression
+	LT_EQ(rhs S) BoolExpression
+	GT(rhs S) BoolExpression
+	GT_EQ(rhs S) BoolExpression
+
+	// CONTAINS returns true if range contains element (range @> element)
+	CONTAINS(element T) BoolExpression
+	// IS_CONTAINED_BY returns true if range is contained by rhs range (range <@ range)
+	IS_CONTAINED_BY(rhs S) BoolExpression
+	// OVERLAP returns true if ranges have points in common (range && range)
+	OVERLAP(rhs S) BoolExpression
+	// STRICT_LEFT_OF returns true if range is strictly left of rhs range (range << range)
+	STRICT_LEFT_OF(rhs S) BoolExpression
+	// STRICT_RIGHT_OF returns true if range is strictly right of rhs range (range >> range)
+	STRICT_RIGHT_OF(rhs S) BoolExpression
+	// ADJACENT returns true if ranges are adjacent (range -|- range)
+	ADJACENT(rhs S) BoolExpression
+
+	// UNION computes union of the ranges (range + range)
+	UNION(rhs S) S
+	// INTERSECTION computes intersection of the ranges (range * range)
+	INTERSECTION(rhs S) S
+	// DIFFERENCE computes difference of the ranges (range - range)
+	DIFFERENCE(rhs S) S
+
+	// LOWER_BOUND returns lower bound of the range, or NULL if range is empty or lower bound is infinite
+	LOWER_BOUND() T
+	// UPPER_BOUND returns upper bound of the range, or NULL if range is empty or upper bound is infinite
+	UPPER_BOUND() T
+	// IS_EMPTY returns true if range is empty
+	IS_EMPTY() BoolExpression
+	// LOWER_INC returns true if lower bound is inclusive
+	LOWER_INC() BoolExpression
+	// UPPER_INC returns true if upper bound is inclusive
+	UPPER_INC() BoolExpression
+	// LOWER_INF returns true if lower bound is infinite
+	LOWER_INF() BoolExpression
+	// UPPER_INF returns true if upper bound is infinite
+	UPPER_INF() BoolExpression
+}
+
+// RangeExpression is representation of postgres range of element expressions of type T
+type RangeExpression[T Expression] interface {
+	rangeExpression[T, RangeExpression[T]]
+
+	// CONTAINS_RANGE returns true if range contains rhs range (range @> range)
+	CONTAINS_RANGE(rhs RangeExpression[T]) BoolExpression
+	// RANGE_MERGE returns the smallest range which includes both of the ranges
+	RANGE_MERGE(rhs RangeExpression[T]) RangeExpression[T]
+}
+
+// MultiRangeExpression is representation of postgres multirange of element expressions of type T. R is element
+// type of the ranges multirange consists of.
+type MultiRangeExpression[T Expression, R Expression] interface {
+	rangeExpression[T, MultiRangeExpression[T, R]]
+
+	// CONTAINS_RANGE returns true if multirange contains rhs range (multirange @> range)
+	CONTAINS_RANGE(rhs RangeExpression[R]) BoolExpression
+	// CONTAINS_MULTIRANGE returns true if multirange contains rhs multirange (multirange @> multirange)
+	CONTAINS_MULTIRANGE(rhs MultiRangeExpression[T, R]) BoolExpression
+	// RANGE_MERGE returns the smallest range which includes the entire multirange
+	RANGE_MERGE() RangeExpression[R]
+}
+
+// Range element types. Each range and multirange type has its own element type, so that expressions of different
+// range types are different go types and can't be mixed in range operators. Any expression of the underlying
+// expression type can be used as range element, for instance Int(5) as Int4RangeElement.
+type (
+	Int4RangeElement interface{ IntegerExpression }
+	Int8RangeElement interface{ IntegerExpression }
+	NumRangeElement  interface{ FloatExpression }
+	TsRangeElement   interface{ TimestampExpression }
+	TsTzRangeElement interface{ TimestampzExpression }
+	DateRangeElement interface{ DateExpression }
+
+	Int4MultiRangeElement interface{ IntegerExpression }
+	Int8MultiRangeElement interface{ IntegerExpression }
+	NumMultiRangeElement  interface{ FloatExpression }
+	TsMultiRangeElement   interface{ TimestampExpression }
+	TsTzMultiRangeElement interface{ TimestampzExpression }
+	DateMultiRangeElement interface{ DateExpression }
+)
+
+// Range and multirange expression types
+type (
+	Int4RangeExpression = RangeExpression[Int4RangeElement]
+	Int8RangeExpression = RangeExpression[Int8RangeElement]
+	NumRangeExpression  = RangeExpression[NumRangeElement]
+	TsRangeExpression   = RangeExpression[TsRangeElement]
+	TsTzRangeExpression = RangeExpression[TsTzRangeElement]
+	DateRangeExpression = RangeExpression[DateRangeElement]
+
+	Int4MultiRangeExpression = MultiRangeExpression[Int4MultiRangeElement, Int4RangeElement]
+	Int8MultiRangeExpression = MultiRangeExpression[Int8MultiRangeElement, Int8RangeElement]
+	NumMultiRangeExpression  = MultiRangeExpression[NumMultiRangeElement, NumRangeElement]
+	TsMultiRangeExpression   = MultiRangeExpression[TsMultiRangeElement, TsRangeElement]
+	TsTzMultiRangeExpression = MultiRangeExpression[TsTzMultiRangeElement, TsTzRangeElement]
+	DateMultiRangeExpression = MultiRangeExpression[DateMultiRangeElement, DateRangeElement]
+)
+
+type rangeExpressionImpl[T Expression, S Expression] struct {
+	parent S
+	// wrap wraps result of range operators as expression of the range type S
+	wrap func(Expression) S
+}
+
+func (r *rangeExpressionImpl[T, S]) isRange(T) {}
+
+func (r *rangeExpressionImpl[T, S]) EQ(rhs S) BoolExpression {
+	return jet.Eq(r.parent, rhs)
+}
+
+func (r *rangeExpressionImpl[T, S]) NOT_EQ(rhs S) BoolExpression {
+	return jet.NotEq(r.parent, rhs)
+}
+
+func (r *rangeExpressionImpl[T, S]) LT(rhs S) BoolExpression {
+	return jet.Lt(r.parent, rhs)
+}
+
+func (r *rangeExpressionImpl[T, S]) LT_EQ(rhs S) BoolExpression {
+	return jet.LtEq(r.parent, rhs)
+}
+
+func (r *rangeExpressionImpl[T, S]) GT(rhs S) BoolExpression {
+	return jet.Gt(r.parent, rhs)
+}
+
+func (r *rangeExpressionImpl[T, S]) GT_EQ(rhs S) BoolExpression {
+	return jet.GtEq(r.parent, rhs)
+}
+
+func (r *rangeExpressionImpl[T, S]) CONTAINS(element T) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(r.parent, element, "@>"))
+}
+
+func (r *rangeExpressionImpl[T, S]) IS_CONTAINED_BY(rhs S) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(r.parent, rhs, "<@"))
+}
+
+func (r *rangeExpressionImpl[T, S]) OVERLAP(rhs S) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(r.parent, rhs, "&&"))
+}
+
+func (r *rangeExpressionImpl[T, S]) STRICT_LEFT_OF(rhs S) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(r.parent, rhs, "<<"))
+}
+
+func (r *rangeExpressionImpl[T, S]) STRICT_RIGHT_OF(rhs S) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(r.parent, rhs, ">>"))
+}
+
+func (r *rangeExpressionImpl[T, S]) ADJACENT(rhs S) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(r.parent, rhs, "-|-"))
+}
+
+func (r *rangeExpressionImpl[T, S]) UNION(rhs S) S {
+	return r.wrap(jet.NewBinaryOperatorExpression(r.parent, rhs, "+"))
+}
+
+func (r *rangeExpressionImpl[T, S]) INTERSECTION(rhs S) S {
+	return r.wrap(jet.NewBinaryOperatorExpression(r.parent, rhs, "*"))
+}
+
+func (r *rangeExpressionImpl[T, S]) DIFFERENCE(rhs S) S {
+	return r.wrap(jet.NewBinaryOperatorExpression(r.parent, rhs, "-"))
+}
+
+func (r *rangeExpressionImpl[T, S]) LOWER_BOUND() T {
+	return rangeElementExp[T](jet.Func("lower", r.parent))
+}
+
+func (r *rangeExpressionImpl[T, S]) UPPER_BOUND() T {
+	return rangeElementExp[T](jet.Func("upper", r.parent))
+}
+
+func (r *rangeExpressionImpl[T, S]) IS_EMPTY() BoolExpression {
+	return BoolExp(jet.Func("isempty", r.parent))
+}
+
+func (r *rangeExpressionImpl[T, S]) LOWER_INC() BoolExpression {
+	return BoolExp(jet.Func("lower_inc", r.parent))
+}
+
+func (r *rangeExpressionImpl[T, S]) UPPER_INC() BoolExpression {
+	return BoolExp(jet.Func("upper_inc", r.parent))
+}
+
+func (r *rangeExpressionImpl[T, S]) LOWER_INF() BoolExpression {
+	return BoolExp(jet.Func("lower_inf", r.parent))
+}
+
+func (r *rangeExpressionImpl[T, S]) UPPER_INF() BoolExpression {
+	return BoolExp(jet.Func("upper_inf", r.parent))
+}
+
+type rangeInterfaceImpl[T Expression] struct {
+	rangeExpressionImpl[T, RangeExpression[T]]
+}
+
+func (r *rangeInterfaceImpl[T]) setParent(parent RangeExpression[T]) {
+	r.parent = parent
+	r.wrap = RangeExp[T]
+}
+
+func (r *rangeInterfaceImpl[T]) CONTAINS_RANGE(rhs RangeExpression[T]) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(r.parent, rhs, "@>"))
+}
+
+func (r *rangeInterfaceImpl[T]) RANGE_MERGE(rhs RangeExpression[T]) RangeExpression[T] {
+	return RangeExp[T](jet.Func("range_merge", r.parent, rhs))
+}
+
+type multiRangeInterfaceImpl[T Expression, R Expression] struct {
+	rangeExpressionImpl[T, MultiRangeExpression[T, R]]
+}
+
+func (m *multiRangeInterfaceImpl[T, R]) setParent(parent MultiRangeExpression[T, R]) {
+	m.parent = parent
+	m.wrap = MultiRangeExp[T, R]
+}
+
+func (m *multiRangeInterfaceImpl[T, R]) CONTAINS_RANGE(rhs RangeExpression[R]) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(m.parent, rhs, "@>"))
+}
+
+func (m *multiRangeInterfaceImpl[T, R]) CONTAINS_MULTIRANGE(rhs MultiRangeExpression[T, R]) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(m.parent, rhs, "@>"))
+}
+
+func (m *multiRangeInterfaceImpl[T, R]) RANGE_MERGE() RangeExpression[R] {
+	return RangeExp[R](jet.Func("range_merge", m.parent))
+}
+
+// rangeElementExp wraps expression as range element expression of type T. Expression is wrapped with the first
+// expression wrapper whose result is of type T.
+func rangeElementExp[T Expression](expression Expression) T {
+	if element, ok := expression.(T); ok {
+		return element
+	}
+
+	wrappers := []func(Expression) Expression{
+		func(e Expression) Expression { return IntExp(e) },
+		func(e Expression) Expression { return FloatExp(e) },
+		func(e Expression) Expression { return DateExp(e) },
+		func(e Expression) Expression { return TimestampExp(e) },
+		func(e Expression) Expression { return TimestampzExp(e) },
+		func(e Expression) Expression { return TimeExp(e) },
+		func(e Expression) Expression { return TimezExp(e) },
+		func(e Expression) Expression { return IntervalExp(e) },
+		func(e Expression) Expression { return StringExp(e) },
+		func(e Expression) Expression { return BoolExp(e) },
+	}
+
+	for _, wrapper := range wrappers {
+		if element, ok := wrapper(expression).(T); ok {
+			return element
+		}
+	}
+
+	var element T
+	panic(fmt.Sprintf("jet: unsupported range element type %s", reflect.TypeOf(&element).Elem()))
+}
+
+type rangeWrapper[T Expression] struct {
+	rangeInterfaceImpl[T]
+	Expression
+}
+
+// RangeExp is range expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as range expression of element type T.
+// Does not add sql cast to generated sql builder output.
+// Element type T should be one of the range element types, or postgres expression type (IntegerExpression,
+// StringExpression, ...), otherwise LOWER_BOUND and UPPER_BOUND panic.
+func RangeExp[T Expression](expression Expression) RangeExpression[T] {
+	rangeWrap := &rangeWrapper[T]{Expression: expression}
+	rangeWrap.rangeInterfaceImpl.setParent(rangeWrap)
+	return rangeWrap
+}
+
+type multiRangeWrapper[T Expression, R Expression] struct {
+	multiRangeInterfaceImpl[T, R]
+	Expression
+}
+
+// MultiRangeExp is multirange expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as multirange expression of element type T, consisting of ranges
+// with element type R. Does not add sql cast to generated sql builder output.
+func MultiRangeExp[T Expression, R Expression](expression Expression) MultiRangeExpression[T, R] {
+	multiRangeWrap := &multiRangeWrapper[T, R]{Expression: expression}
+	multiRangeWrap.multiRangeInterfaceImpl.setParent(multiRangeWrap)
+	return multiRangeWrap
+}
+
+//------------------ Range constructors ------------------//
+
+// INT4RANGE constructs integer range from lower and upper bound. Optional bounds specifies inclusive ([ ])
+// or exclusive (( )) bounds, if omitted lower bound is inclusive and upper bound is exclusive: [).
+func INT4RANGE(lower, upper IntegerExpression, bounds ...StringExpression) Int4RangeExpression {
+	return RangeExp[Int4RangeElement](jet.Func("int4range", rangeConstructorArgs(lower, upper, bounds)...))
+}
+
+// INT8RANGE constructs bigint range from lower and upper bound, with optional bounds ("[)" by default).
+func INT8RANGE(lower, upper IntegerExpression, bounds ...StringExpression) Int8RangeExpression {
+	return RangeExp[Int8RangeElement](jet.Func("int8range", rangeConstructorArgs(lower, upper, bounds)...))
+}
+
+// NUMRANGE constructs numeric range from lower and upper bound, with optional bounds ("[)" by default).
+func NUMRANGE(lower, upper NumericExpression, bounds ...StringExpression) NumRangeExpression {
+	return RangeExp[NumRangeElement](jet.Func("numrange", rangeConstructorArgs(lower, upper, bounds)...))
+}
+
+// TSRANGE constructs timestamp without time zone range from lower and upper bound, with optional
+// bounds ("[)" by default).
+func TSRANGE(lower, upper TimestampExpression, bounds ...StringExpression) TsRangeExpression {
+	return RangeExp[TsRangeElement](jet.Func("tsrange", rangeConstructorArgs(lower, upper, bounds)...))
+}
+
+// TSTZRANGE constructs timestamp with time zone range from lower and upper bound, with optional
+// bounds ("[)" by default).
+//
+//	TSTZRANGE(TimestampzT(from), TimestampzT(to), String("[]"))
+func TSTZRANGE(lower, upper TimestampzExpression, bounds ...StringExpression) TsTzRangeExpression {
+	return RangeExp[TsTzRangeElement](jet.Func("tstzrange", rangeConstructorArgs(lower, upper, bounds)...))
+}
+
+// DATERANGE constructs date range from lower and upper bound, with optional bounds ("[)" by default).
+func DATERANGE(lower, upper DateExpression, bounds ...StringExpression) DateRangeExpression {
+	return RangeExp[DateRangeElement](jet.Func("daterange", rangeConstructorArgs(lower, upper, bounds)...))
+}
+
+func rangeConstructorArgs(lower, upper Expression, bounds []StringExpression) []Expression {
+	args := []Expression{lower, upper}
+
+	if len(bounds) > 0 {
+		args = append(args, bounds[0])
+	}
+
+	return args
+}
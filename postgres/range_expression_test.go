@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	bookingColRoom   = IntegerColumn("room")
+	bookingColSeats  = Int4RangeColumn("seats")
+	bookingColPeriod = TsTzRangeColumn("period")
+	bookingColDays   = DateMultiRangeColumn("days")
+	bookingTable     = NewTable("db", "booking", "", bookingColRoom, bookingColSeats, bookingColPeriod, bookingColDays)
+)
+
+func TestRangeExpressionOperators(t *testing.T) {
+	seats := INT4RANGE(Int(1), Int(10))
+
+	assertSerialize(t, bookingColSeats.EQ(seats), "(booking.seats = int4range($1, $2))", int64(1), int64(10))
+	assertSerialize(t, bookingColSeats.NOT_EQ(bookingColSeats), "(booking.seats != booking.seats)")
+	assertSerialize(t, bookingColSeats.LT(bookingColSeats), "(booking.seats < booking.seats)")
+	assertSerialize(t, bookingColSeats.GT_EQ(bookingColSeats), "(booking.seats >= booking.seats)")
+	assertSerialize(t, bookingColSeats.CONTAINS(Int(5)), "(booking.seats @> $1)", int64(5))
+	assertSerialize(t, bookingColSeats.CONTAINS_RANGE(seats), "(booking.seats @> int4range($1, $2))", int64(1), int64(10))
+	assertSerialize(t, bookingColSeats.IS_CONTAINED_BY(seats), "(booking.seats <@ int4range($1, $2))", int64(1), int64(10))
+	assertSerialize(t, bookingColSeats.OVERLAP(bookingColSeats), "(booking.seats && booking.seats)")
+	assertSerialize(t, bookingColSeats.STRICT_LEFT_OF(bookingColSeats), "(booking.seats << booking.seats)")
+	assertSerialize(t, bookingColSeats.STRICT_RIGHT_OF(bookingColSeats), "(booking.seats >> booking.seats)")
+	assertSerialize(t, bookingColSeats.ADJACENT(bookingColSeats), "(booking.seats -|- booking.seats)")
+	assertSerialize(t, bookingColSeats.UNION(seats).INTERSECTION(bookingColSeats).DIFFERENCE(bookingColSeats),
+		"(((booking.seats + int4range($1, $2)) * booking.seats) - booking.seats)", int64(1), int64(10))
+}
+
+func TestMultiRangeExpressionOperators(t *testing.T) {
+	from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	days := DATERANGE(DateT(from), DateT(from.AddDate(0, 0, 7)))
+
+	assertSerialize(t, bookingColDays.CONTAINS(CURRENT_DATE()), "(booking.days @> CURRENT_DATE)")
+	assertSerialize(t, bookingColDays.CONTAINS_RANGE(days), "(booking.days @> daterange($1::date, $2::date))",
+		from, from.AddDate(0, 0, 7))
+	assertSerialize(t, bookingColDays.CONTAINS_MULTIRANGE(bookingColDays), "(booking.days @> booking.days)")
+	assertSerialize(t, bookingColDays.OVERLAP(bookingColDays), "(booking.days && booking.days)")
+	assertSerialize(t, bookingColDays.UNION(bookingColDays).DIFFERENCE(bookingColDays),
+		"((booking.days + booking.days) - booking.days)")
+	assertSerialize(t, bookingColDays.RANGE_MERGE().CONTAINS(CURRENT_DATE()),
+		"(range_merge(booking.days) @> CURRENT_DATE)")
+	assertSerialize(t, bookingColDays.RANGE_MERGE().RANGE_MERGE(days),
+		"range_merge(range_merge(booking.days), daterange($1::date, $2::date))", from, from.AddDate(0, 0, 7))
+}
+
+func TestRangeExpressionFunctions(t *testing.T) {
+	assertSerialize(t, bookingColSeats.LOWER_BOUND().ADD(Int(1)), "(lower(booking.seats) + $1)", int64(1))
+	assertSerialize(t, bookingColPeriod.UPPER_BOUND().LT(NOW()), "(upper(booking.period) < NOW())")
+	assertSerialize(t, bookingColDays.LOWER_BOUND().EQ(CURRENT_DATE()), "(lower(booking.days) = CURRENT_DATE)")
+	assertSerialize(t, bookingColSeats.IS_EMPTY(), "isempty(booking.seats)")
+	assertSerialize(t, bookingColSeats.LOWER_INC(), "lower_inc(booking.seats)")
+	assertSerialize(t, bookingColSeats.UPPER_INC(), "upper_inc(booking.seats)")
+	assertSerialize(t, bookingColSeats.LOWER_INF(), "lower_inf(booking.seats)")
+	assertSerialize(t, bookingColSeats.UPPER_INF(), "upper_inf(booking.seats)")
+	assertSerialize(t, bookingColSeats.RANGE_MERGE(INT4RANGE(Int(20), Int(30), String("[]"))),
+		"range_merge(booking.seats, int4range($1, $2, $3::text))", int64(20), int64(30), "[]")
+}
+
+func TestRangeTypesAreDistinct(t *testing.T) {
+	require.NotEqual(t, reflect.TypeOf((*ColumnInt4Range)(nil)), reflect.TypeOf((*ColumnInt8Range)(nil)))
+	require.NotEqual(t, reflect.TypeOf((*ColumnInt4Range)(nil)), reflect.TypeOf((*ColumnInt4MultiRange)(nil)))
+	require.NotEqual(t, reflect.TypeOf((*ColumnTsRange)(nil)), reflect.TypeOf((*ColumnTsMultiRange)(nil)))
+	require.NotEqual(t, reflect.TypeOf((*DateRangeExpression)(nil)), reflect.TypeOf((*DateMultiRangeExpression)(nil)))
+}
+
+func TestRangeElementExp(t *testing.T) {
+	assertSerialize(t, RangeExp[IntegerExpression](Raw("r")).LOWER_BOUND().ADD(Int(1)), "(lower(r) + $1)", int64(1))
+	assertSerialize(t, RangeExp[StringExpression](Raw("r")).UPPER_BOUND().CONCAT(String("a")),
+		"(upper(r) || $1::text)", "a")
+	assertSerialize(t, RangeExp[NumRangeElement](Raw("r")).LOWER_BOUND().GT(Float(1.5)), "(lower(r) > $1)", 1.5)
+	assertSerialize(t, RangeExp[Expression](Raw("r")).UPPER_BOUND(), "upper(r)")
+	assertSerialize(t, MultiRangeExp[Int4MultiRangeElement, Int4RangeElement](Raw("mr")).RANGE_MERGE().UPPER_BOUND(),
+		"upper(range_merge(mr))")
+
+	type customExpression interface {
+		IntegerExpression
+		Custom()
+	}
+
+	assertPanicErr(t, func() { RangeExp[customExpression](Raw("r")).LOWER_BOUND() },
+		"jet: unsupported range element type postgres.customExpression")
+}
+
+func TestRangeConstructors(t *testing.T) {
+	from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	assertSerialize(t, INT8RANGE(Int(1), Int(2), String("(]")), "int8range($1, $2, $3::text)", int64(1), int64(2), "(]")
+	assertSerialize(t, NUMRANGE(Float(1.5), Int(2)), "numrange($1, $2)", 1.5, int64(2))
+	assertSerialize(t, TSRANGE(TimestampT(from), TimestampT(to)),
+		"tsrange($1::timestamp without time zone, $2::timestamp without time zone)", from, to)
+	assertSerialize(t, TSTZRANGE(TimestampzT(from), TimestampzT(to), String("[]")),
+		"tstzrange($1::timestamp with time zone, $2::timestamp with time zone, $3::text)", from, to, "[]")
+	assertSerialize(t, DATERANGE(DateT(from), DateT(to)), "daterange($1::date, $2::date)", from, to)
+	assertSerialize(t, CAST(String("[1,5)")).AS("int4range"), "$1::text::int4range", "[1,5)")
+}
+
+func TestRangeSelectStatement(t *testing.T) {
+	from := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	subQuery := SELECT(bookingColRoom, bookingColPeriod).FROM(bookingTable).AsTable("sub")
+	period := bookingColPeriod.From(subQuery)
+
+	stmt := SELECT(
+		period.LOWER_BOUND().AS("start"),
+	).FROM(
+		subQuery,
+	).WHERE(
+		period.OVERLAP(TSTZRANGE(TimestampzT(from), TimestampzT(from.Add(time.Hour)))),
+	)
+
+	assertDebugStatementSql(t, stmt, `
+SELECT lower(sub."booking.period") AS "start"
+FROM (
+          SELECT booking.room AS "booking.room",
+               booking.period AS "booking.period"
+          FROM db.booking
+     ) AS sub
+WHERE sub."booking.period" && tstzrange('2020-01-01 10:00:00Z'::timestamp with time zone, '2020-01-01 11:00:00Z'::timestamp with time zone);
+`)
+}
@@ -1,9 +1,11 @@
 package postgres
 
 import (
+	"context"
 	"math"
 
 	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
 )
 
 // RowLock is interface for SELECT statement row lock types
@@ -53,7 +55,10 @@ type SelectStatement interface {
 	ORDER_BY(orderByClauses ...OrderByClause) SelectStatement
 	LIMIT(limit int64) SelectStatement
 	OFFSET(offset int64) SelectStatement
-	FOR(lock RowLock) SelectStatement
+	// FETCH_FIRST is SQL standard alternative to LIMIT. Statement with both FETCH_FIRST and LIMIT, or with
+	// FETCH_FIRST WITH_TIES and without ORDER_BY, panics when serialized.
+	FETCH_FIRST(count int64) fetchExpand
+	FOR(lock RowLock, locks ...RowLock) SelectStatement
 
 	UNION(rhs SelectStatement) setStatement
 	UNION_ALL(rhs SelectStatement) setStatement
@@ -63,6 +68,22 @@ type SelectStatement interface {
 	EXCEPT_ALL(rhs SelectStatement) setStatement
 
 	AsTable(alias string) SelectTable
+
+	// CountStatement returns new statement that counts the number of rows this statement returns,
+	// with ORDER BY, LIMIT, OFFSET and row lock clauses removed.
+	CountStatement() Statement
+	// QueryWithCount executes statement and stores row results in destination. Total number of rows,
+	// without LIMIT and OFFSET applied, is retrieved with CountStatement.
+	// Data and count queries are executed separately, so for a consistent result db should be a transaction with
+	// REPEATABLE READ or SERIALIZABLE isolation level.
+	QueryWithCount(ctx context.Context, db qrm.Queryable, destination interface{}) (totalCount int64, err error)
+	// JSONNestedStatement returns new statement, where projections mapped into destination fields of slice or map
+	// of structs type are aggregated into JSON arrays with correlated sub-queries, instead of being joined into the
+	// result set. Tables of the nested projections are moved from the FROM clause into the sub-queries, and ON condition
+	// of the first moved table becomes sub-query WHERE condition, so rows without nested rows are not filtered out.
+	// LIMIT and OFFSET of the new statement apply to the destination rows only. Statement has to be queried into
+	// destination of the same type.
+	JSONNestedStatement(destination interface{}) Statement
 }
 
 // SELECT creates new SelectStatement with list of projections
@@ -70,11 +91,25 @@ func SELECT(projection Projection, projections ...Projection) SelectStatement {
 	return newSelectStatement(nil, append([]Projection{projection}, projections...))
 }
 
+// SELECT_JSON_ARR creates new SelectStatement that aggregates all the selected rows into a single JSON array of
+// objects. Object keys are projection aliases, so the statement can be used as a nested projection (for instance
+// SELECT_JSON_ARR(Actor.AllColumns).FROM(Actor).WHERE(...).AS("actors")), and query result mapping will decode
+// the JSON array into destination slice field using the same alias conventions as for the result set columns.
+func SELECT_JSON_ARR(projection Projection, projections ...Projection) SelectStatement {
+	return newSelectJSONArrStatement(append([]Projection{projection}, projections...))
+}
+
+var jsonAggregation = jet.JSONAggregation{
+	ObjectFunc:      "json_build_object",
+	ArrayAgg:        "COALESCE(json_agg(%s), '[]')",
+	OrderedArrayAgg: "COALESCE(json_agg(%s ORDER BY %s), '[]')",
+}
+
 func newSelectStatement(table ReadableTable, projections []Projection) SelectStatement {
 	newSelect := &selectStatementImpl{}
 	newSelect.ExpressionStatement = jet.NewExpressionStatementImpl(Dialect, jet.SelectStatementType, newSelect, &newSelect.Select,
 		&newSelect.From, &newSelect.Where, &newSelect.GroupBy, &newSelect.Having, &newSelect.Window, &newSelect.OrderBy,
-		&newSelect.Limit, &newSelect.Offset, &newSelect.For)
+		&newSelect.Limit, &newSelect.Offset, &newSelect.Fetch, &newSelect.For)
 
 	newSelect.Select.ProjectionList = projections
 	if table != nil {
@@ -82,12 +117,28 @@ func newSelectStatement(table ReadableTable, projections []Projection) SelectSta
 	}
 	newSelect.Limit.Count = -1
 	newSelect.Offset.Count = -1
+	newSelect.For.From = &newSelect.From
+	newSelect.Fetch.Count = -1
+	newSelect.Fetch.Limit = &newSelect.Limit
+	newSelect.Fetch.OrderBy = &newSelect.OrderBy
 
 	newSelect.setOperatorsImpl.parent = newSelect
 
 	return newSelect
 }
 
+func newSelectJSONArrStatement(projections []Projection) SelectStatement {
+	newSelect := newSelectStatement(nil, nil).(*selectStatementImpl)
+	newSelect.Select.ProjectionList = []Projection{jet.NewJSONArrayAggProjection(jsonAggregation, projections, &newSelect.OrderBy)}
+	newSelect.ExpressionStatement = jet.NewExpressionStatementImpl(Dialect, jet.SelectStatementType, newSelect,
+		&jet.ClauseJSONArrayAgg{Aggregation: jsonAggregation, OrderBy: &newSelect.OrderBy}, &newSelect.Select,
+		&newSelect.From, &newSelect.Where, &newSelect.GroupBy, &newSelect.Having, &newSelect.Window, &newSelect.OrderBy,
+		&newSelect.Limit, &newSelect.Offset, &newSelect.Fetch, &newSelect.For,
+		&jet.ClauseJSONArrayAgg{Aggregation: jsonAggregation, End: true})
+
+	return newSelect
+}
+
 type selectStatementImpl struct {
 	jet.ExpressionStatement
 	setOperatorsImpl
@@ -101,6 +152,7 @@ type selectStatementImpl struct {
 	OrderBy jet.ClauseOrderBy
 	Limit   jet.ClauseLimit
 	Offset  jet.ClauseOffset
+	Fetch   jet.ClauseFetch
 	For     jet.ClauseFor
 }
 
@@ -150,8 +202,13 @@ func (s *selectStatementImpl) OFFSET(offset int64) SelectStatement {
 	return s
 }
 
-func (s *selectStatementImpl) FOR(lock RowLock) SelectStatement {
-	s.For.Lock = lock
+func (s *selectStatementImpl) FETCH_FIRST(count int64) fetchExpand {
+	s.Fetch.Count = count
+	return fetchExpand{selectStatement: s}
+}
+
+func (s *selectStatementImpl) FOR(lock RowLock, locks ...RowLock) SelectStatement {
+	s.For.Locks = append([]RowLock{lock}, locks...)
 	return s
 }
 
@@ -159,6 +216,27 @@ func (s *selectStatementImpl) AsTable(alias string) SelectTable {
 	return newSelectTable(s, alias)
 }
 
+func (s *selectStatementImpl) CountStatement() Statement {
+	return jet.NewCountStatement(Dialect, &s.Select, &s.From, &s.Where, &s.GroupBy, &s.Having, &s.Window)
+}
+
+func (s *selectStatementImpl) QueryWithCount(ctx context.Context, db qrm.Queryable, destination interface{}) (totalCount int64, err error) {
+	return jet.QueryWithCount(ctx, db, s, s.CountStatement(), destination)
+}
+
+func (s *selectStatementImpl) JSONNestedStatement(destination interface{}) Statement {
+	nested := newSelectStatement(nil, nil).(*selectStatementImpl)
+	nested.Select, nested.From, nested.Where, nested.GroupBy = s.Select, s.From, s.Where, s.GroupBy
+	nested.Having, nested.Window, nested.OrderBy = s.Having, s.Window, s.OrderBy
+	nested.Limit, nested.Offset, nested.For.Locks = s.Limit, s.Offset, s.For.Locks
+	nested.Fetch.Count, nested.Fetch.WithTies = s.Fetch.Count, s.Fetch.WithTies
+
+	jet.NestJSONProjections(Dialect, jsonAggregation, destination, &nested.Select, &nested.From, &nested.OrderBy,
+		&nested.Where, &nested.GroupBy, &nested.Having, &nested.Window)
+
+	return nested
+}
+
 //-----------------------------------------------------
 
 type windowExpand struct {
@@ -174,6 +252,23 @@ func (w windowExpand) AS(window ...jet.Window) SelectStatement {
 	return w.selectStatement
 }
 
+type fetchExpand struct {
+	selectStatement *selectStatementImpl
+}
+
+// ROWS_ONLY returns only the first count rows
+func (f fetchExpand) ROWS_ONLY() SelectStatement {
+	f.selectStatement.Fetch.WithTies = false
+	return f.selectStatement
+}
+
+// WITH_TIES returns the first count rows, plus any additional rows that tie for the last place according to
+// ORDER BY clause. WITH TIES requires ORDER BY clause.
+func (f fetchExpand) WITH_TIES() SelectStatement {
+	f.selectStatement.Fetch.WithTies = true
+	return f.selectStatement
+}
+
 func toJetFrameOffset(offset int64) jet.Serializer {
 	if offset == UNBOUNDED {
 		return jet.UNBOUNDED
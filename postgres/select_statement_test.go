@@ -111,6 +111,72 @@ OFFSET $2;
 `, int64(10), int64(2))
 }
 
+func TestSelectLockOf(t *testing.T) {
+	assertStatementSql(t,
+		SELECT(table1ColInt, table2ColInt).
+			FROM(table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))).
+			FOR(UPDATE().OF(table1).SKIP_LOCKED()), `
+SELECT table1.col_int AS "table1.col_int",
+     table2.col_int AS "table2.col_int"
+FROM db.table1
+     INNER JOIN db.table2 ON (table1.col_int = table2.col_int)
+FOR UPDATE OF table1 SKIP LOCKED;
+`)
+
+	table3AliasColInt := IntegerColumn("col_int")
+	table3Alias := NewTable("db", "table3", "t3", table3AliasColInt)
+
+	assertStatementSql(t,
+		SELECT(table1ColInt).
+			FROM(table1, table2.LEFT_JOIN(table3Alias, table2ColInt.EQ(table3AliasColInt))).
+			FOR(NO_KEY_UPDATE().OF(table1, table3Alias).NOWAIT(), SHARE().OF(table2)), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1,
+     db.table2
+     LEFT JOIN db.table3 AS t3 ON (table2.col_int = t3.col_int)
+FOR NO KEY UPDATE OF table1, t3 NOWAIT
+FOR SHARE OF table2;
+`)
+
+	assertStatementSqlErr(t,
+		SELECT(table1ColInt).FROM(table1).FOR(UPDATE().OF(table2)),
+		"jet: row lock table 'table2' does not appear in FROM clause",
+	)
+	assertStatementSqlErr(t,
+		SELECT(table1ColInt).FROM(table1).FOR(UPDATE(), SHARE().OF(table3Alias)),
+		"jet: row lock table 't3' does not appear in FROM clause",
+	)
+}
+
+func TestSelectFetchFirst(t *testing.T) {
+	assertStatementSql(t, SELECT(table2ColInt).FROM(table2).FETCH_FIRST(10).ROWS_ONLY(), `
+SELECT table2.col_int AS "table2.col_int"
+FROM db.table2
+FETCH FIRST $1 ROWS ONLY;
+`, int64(10))
+	assertStatementSql(t, SELECT(table2ColInt).FROM(table2).ORDER_BY(table2ColInt.DESC()).OFFSET(5).FETCH_FIRST(3).WITH_TIES(), `
+SELECT table2.col_int AS "table2.col_int"
+FROM db.table2
+ORDER BY table2.col_int DESC
+OFFSET $1
+FETCH FIRST $2 ROWS WITH TIES;
+`, int64(5), int64(3))
+	assertDebugStatementSql(t, SELECT(table2ColInt).FROM(table2).ORDER_BY(table2ColInt).FETCH_FIRST(3).ROWS_ONLY().FOR(SHARE()), `
+SELECT table2.col_int AS "table2.col_int"
+FROM db.table2
+ORDER BY table2.col_int
+FETCH FIRST 3 ROWS ONLY
+FOR SHARE;
+`)
+
+	assertPanicErr(t, func() {
+		SELECT(table2ColInt).FROM(table2).LIMIT(5).FETCH_FIRST(3).ROWS_ONLY().Sql()
+	}, "jet: FETCH FIRST and LIMIT clauses can't be used in the same statement")
+	assertPanicErr(t, func() {
+		SELECT(table2ColInt).FROM(table2).FETCH_FIRST(3).WITH_TIES().Sql()
+	}, "jet: FETCH FIRST WITH TIES requires ORDER BY clause")
+}
+
 func TestSelectLock(t *testing.T) {
 	assertStatementSql(t, SELECT(table1ColBool).FROM(table1).FOR(UPDATE()), `
 SELECT table1.col_bool AS "table1.col_bool"
@@ -134,3 +200,166 @@ FROM db.table1
 FOR NO KEY UPDATE SKIP LOCKED;
 `)
 }
+
+func TestSelectCountStatement(t *testing.T) {
+	stmt := SELECT(table1ColInt, table2ColFloat).DISTINCT().
+		FROM(table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))).
+		WHERE(table1ColBool.IS_TRUE()).
+		GROUP_BY(table1ColInt, table2ColFloat).
+		ORDER_BY(table1ColInt.DESC()).
+		LIMIT(10).
+		OFFSET(20).
+		FOR(UPDATE())
+
+	assertStatementSql(t, stmt.CountStatement(), `
+SELECT COUNT(*) AS "count"
+FROM (
+          SELECT DISTINCT table1.col_int AS "table1.col_int",
+               table2.col_float AS "table2.col_float"
+          FROM db.table1
+               INNER JOIN db.table2 ON (table1.col_int = table2.col_int)
+          WHERE table1.col_bool IS TRUE
+          GROUP BY table1.col_int, table2.col_float
+     ) AS count_subquery;
+`)
+	// original statement is not modified
+	assertStatementSql(t, stmt, `
+SELECT DISTINCT table1.col_int AS "table1.col_int",
+     table2.col_float AS "table2.col_float"
+FROM db.table1
+     INNER JOIN db.table2 ON (table1.col_int = table2.col_int)
+WHERE table1.col_bool IS TRUE
+GROUP BY table1.col_int, table2.col_float
+ORDER BY table1.col_int DESC
+LIMIT $1
+OFFSET $2
+FOR UPDATE;
+`, int64(10), int64(20))
+}
+
+func TestSelectJSONArr(t *testing.T) {
+	assertStatementSql(t, SELECT(
+		table1ColInt,
+		SELECT_JSON_ARR(table2ColInt, table2ColFloat.ADD(Float(1.5)).AS("float_plus")).
+			FROM(table2).
+			WHERE(table2ColInt.EQ(table1ColInt)).
+			ORDER_BY(table2ColInt).
+			LIMIT(5).AS("table2s"),
+	).FROM(table1), `
+SELECT table1.col_int AS "table1.col_int",
+     (
+          SELECT json_build_object('__jet_rows', COALESCE(json_agg(records.j ORDER BY records.o), '[]'))
+          FROM (
+               SELECT json_build_object(
+                         'table2.col_int', table2.col_int,
+                         'float_plus', (table2.col_float + $1)
+                    ) AS "j",
+                    ROW_NUMBER() OVER (ORDER BY table2.col_int) AS "o"
+               FROM db.table2
+               WHERE table2.col_int = table1.col_int
+               ORDER BY table2.col_int
+               LIMIT $2
+          ) AS records
+     ) AS "table2s"
+FROM db.table1;
+`, 1.5, int64(5))
+
+	assertStatementSql(t, SELECT_JSON_ARR(ColumnList{table1ColInt, table1ColBool}).FROM(table1), `
+SELECT json_build_object('__jet_rows', COALESCE(json_agg(records.j), '[]'))
+FROM (
+     SELECT json_build_object(
+               'table1.col_int', table1.col_int,
+               'table1.col_bool', table1.col_bool
+          ) AS "j"
+     FROM db.table1
+) AS records;
+`)
+
+	assertPanicErr(t, func() { SELECT_JSON_ARR(Int(1)) }, "jet: unsupported JSON object projection, only columns and aliased expressions are allowed")
+}
+
+func TestSelectJSONNestedStatement(t *testing.T) {
+	type Table3 struct {
+		Col1 int32  `sql:"primary_key" alias:"table3.col1"`
+		Col2 string `alias:"table3.col2"`
+	}
+
+	var dest []struct {
+		ColInt  int32 `sql:"primary_key" alias:"table1.col_int"`
+		Table3s []Table3
+	}
+
+	stmt := SELECT(
+		table1ColInt,
+		table3Col1,
+		table3StrCol,
+	).FROM(
+		table1.
+			INNER_JOIN(table2, table2ColInt.EQ(table1ColInt)).
+			LEFT_JOIN(table3, table3ColInt.EQ(table2ColInt)),
+	).WHERE(
+		table1ColBool.IS_TRUE(),
+	).ORDER_BY(
+		table1ColInt,
+		table3Col1.DESC(),
+	).LIMIT(10)
+
+	assertStatementSql(t, stmt.JSONNestedStatement(&dest), `
+SELECT table1.col_int AS "table1.col_int",
+     (
+          SELECT json_build_object('__jet_rows', COALESCE(json_agg(records.j ORDER BY records.o), '[]'))
+          FROM (
+               SELECT json_build_object(
+                         'table3.col1', table3.col1,
+                         'table3.col2', table3.col2
+                    ) AS "j",
+                    ROW_NUMBER() OVER (ORDER BY table3.col1 DESC) AS "o"
+               FROM db.table2
+                    LEFT JOIN db.table3 ON (table3.col_int = table2.col_int)
+               WHERE table2.col_int = table1.col_int
+               ORDER BY table3.col1 DESC
+          ) AS records
+     ) AS "table3s"
+FROM db.table1
+WHERE table1.col_bool IS TRUE
+ORDER BY table1.col_int
+LIMIT $1;
+`, int64(10))
+
+	t.Run("no nested projections", func(t *testing.T) {
+		var dest []struct {
+			ColInt int32 `alias:"table1.col_int"`
+		}
+
+		assertStatementSql(t, SELECT(table1ColInt).FROM(table1.INNER_JOIN(table2, table2ColInt.EQ(table1ColInt))).
+			JSONNestedStatement(&dest), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+     INNER JOIN db.table2 ON (table2.col_int = table1.col_int);
+`)
+	})
+
+	assertPanicErr(t, func() {
+		stmt.WHERE(table3StrCol.EQ(String("x"))).JSONNestedStatement(&dest)
+	}, "jet: WHERE, GROUP BY and HAVING clauses can't reference table 'table3' aggregated into JSON nested field 'table3s'")
+
+	assertPanicErr(t, func() {
+		stmt.WHERE(nil).ORDER_BY(table1ColInt.ADD(table3Col1)).JSONNestedStatement(&dest)
+	}, "jet: ORDER BY clause can't reference tables from different JSON nesting levels")
+
+	assertPanicErr(t, func() {
+		SELECT(table1ColInt, table3Col1).FROM(table3.INNER_JOIN(table1, table1ColInt.EQ(table3ColInt))).JSONNestedStatement(&dest)
+	}, "jet: first table of the FROM clause has to be mapped into the destination, not into JSON nested field")
+
+	assertPanicErr(t, func() {
+		SELECT(table1ColInt, table3Col1).FROM(table1.CROSS_JOIN(table3)).JSONNestedStatement(&dest)
+	}, "jet: table 'table3' aggregated into JSON nested field 'table3s' has to be INNER or LEFT joined with ON condition")
+
+	assertPanicErr(t, func() {
+		SELECT(table1ColInt, table3Col1).FROM(table1).JSONNestedStatement(&dest)
+	}, "jet: FROM clause does not contain any table of the projections mapped into JSON nested field 'table3s'")
+
+	assertPanicErr(t, func() {
+		SELECT(table1ColInt, table3Col1).FROM(table1, table3).JSONNestedStatement(&dest)
+	}, "jet: JSON nesting requires FROM clause with a single table or join of tables")
+}
@@ -0,0 +1,34 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// Sequence is interface for PostgreSQL sequence objects
+type Sequence interface {
+	jet.Sequence
+
+	// FromSchema creates new sequence from the same sequence name in a different schema
+	FromSchema(schemaName string) Sequence
+}
+
+type sequenceImpl struct {
+	jet.Sequence
+}
+
+// NewSequence creates new sequence with the schema name and sequence name
+//
+//	FilmFilmIDSeq := NewSequence("dvds", "film_film_id_seq")
+//	SELECT(FilmFilmIDSeq.NEXTVAL())
+func NewSequence(schemaName, name string) Sequence {
+	return &sequenceImpl{
+		Sequence: jet.NewSequence(jet.SequenceFunctions{
+			NextVal:     "nextval",
+			CurrVal:     "currval",
+			SetVal:      "setval",
+			NameLiteral: true,
+		}, schemaName, name),
+	}
+}
+
+func (s *sequenceImpl) FromSchema(schemaName string) Sequence {
+	return NewSequence(schemaName, s.SequenceName())
+}
@@ -0,0 +1,28 @@
+package postgres
+
+import "testing"
+
+func TestSequence(t *testing.T) {
+	filmSeq := NewSequence("dvds", "film_film_id_seq")
+
+	assertSerialize(t, filmSeq.NEXTVAL(), "nextval('dvds.film_film_id_seq')")
+	assertSerialize(t, filmSeq.CURRVAL(), "currval('dvds.film_film_id_seq')")
+	assertSerialize(t, filmSeq.SETVAL(Int(100)), "setval('dvds.film_film_id_seq', $1)", int64(100))
+
+	assertSerialize(t, filmSeq.FromSchema("public").NEXTVAL(), "nextval('public.film_film_id_seq')")
+	assertSerialize(t, NewSequence("", "film_film_id_seq").NEXTVAL(), "nextval('film_film_id_seq')")
+	assertSerialize(t, NewSequence("Dvds", "Film's_seq").NEXTVAL(), `nextval('"Dvds"."Film''s_seq"')`)
+}
+
+func TestSequenceInStatement(t *testing.T) {
+	filmSeq := NewSequence("dvds", "film_film_id_seq")
+
+	assertStatementSql(t, SELECT(filmSeq.NEXTVAL().ADD(Int(1)).AS("next_id")), `
+SELECT (nextval('dvds.film_film_id_seq') + $1) AS "next_id";
+`, int64(1))
+
+	assertStatementSql(t, table1.INSERT(table1ColInt).VALUES(filmSeq.NEXTVAL()), `
+INSERT INTO db.table1 (col_int)
+VALUES (nextval('dvds.film_film_id_seq'));
+`)
+}
@@ -1,8 +1,50 @@
 package postgres
 
-import "github.com/go-jet/jet/v2/internal/jet"
+import (
+	"context"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
 
 // RawStatement creates new sql statements from raw query and optional map of named arguments
 func RawStatement(rawQuery string, namedArguments ...RawArgs) Statement {
 	return jet.RawStatement(Dialect, rawQuery, namedArguments...)
 }
+
+// MultiStatement creates new statement that executes the list of statements in a single database round trip.
+// Use QueryMultiple to map each of the statement result sets into its own destination.
+// PostgreSQL extended query protocol does not allow multiple statements in a parametrized query, so arguments of the
+// statements are inlined into the query as SQL literals, and the query is executed without arguments. Byte slices
+// are inlined as bytea hex literals, and statement execution returns an error for the argument types that can not
+// be safely inlined.
+func MultiStatement(statements ...Statement) Statement {
+	return jet.NewInlinedMultiStatement(Dialect, statements...)
+}
+
+// QueryMultiple executes statement returning multiple result sets over database connection/transaction db, and maps
+// each of the result sets into destination at the same index. Statement can be created with MultiStatement, or it can
+// be a raw statement returning multiple result sets, for instance a stored procedure call.
+func QueryMultiple(ctx context.Context, statement Statement, db qrm.Queryable, destinations ...interface{}) error {
+	return jet.QueryMultiple(ctx, statement, db, destinations...)
+}
+
+// QueryAll executes statement over database connection/transaction db and returns all the mapped rows as a slice of T.
+// T can be a struct or pointer to a struct, a simple type like int64 or string (first column of each row is returned),
+// or map[string]interface{}.
+func QueryAll[T any](ctx context.Context, statement Statement, db qrm.Queryable) ([]T, error) {
+	return jet.QueryAll[T](ctx, statement, db)
+}
+
+// QueryOne executes statement over database connection/transaction db and returns the first mapped T object.
+// If query result set is empty, QueryOne returns qrm.ErrNoRows.
+func QueryOne[T any](ctx context.Context, statement Statement, db qrm.Queryable) (T, error) {
+	return jet.QueryOne[T](ctx, statement, db)
+}
+
+// QueryScalar executes statement over database connection/transaction db and returns the single column value
+// of the first row, for example the result of SELECT(COUNT(STAR)). If query result set is empty, QueryScalar
+// returns qrm.ErrNoRows.
+func QueryScalar[T any](ctx context.Context, statement Statement, db qrm.Queryable) (T, error) {
+	return jet.QueryScalar[T](ctx, statement, db)
+}
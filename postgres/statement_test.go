@@ -0,0 +1,50 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiStatement(t *testing.T) {
+	stmt := MultiStatement(
+		SELECT(table1ColInt).FROM(table1).WHERE(table1ColInt.GT(Int(10))).LIMIT(5),
+		SELECT(COUNT(STAR)).FROM(table1).WHERE(table1ColInt.GT(Int(10))),
+		RawStatement("SELECT :arg AS \"arg\"", RawArgs{":arg": "raw"}),
+	)
+
+	assertStatementSql(t, stmt, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int > 10
+LIMIT 5;
+SELECT COUNT(*)
+FROM db.table1
+WHERE table1.col_int > 10; SELECT 'raw' AS "arg";
+`)
+
+	assertStatementSql(t, MultiStatement(
+		SELECT(String("it's")),
+		SELECT(table1ColInt).FROM(table1).WHERE(table1ColInt.EQ(Int(1))),
+	), `
+SELECT 'it''s'::text;
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int = 1;
+`)
+
+	assertStatementSql(t, MultiStatement(
+		SELECT(Bytea([]byte{0x00, 0xde, 0xad})),
+		SELECT(String(`a\b`)),
+	), `
+SELECT '\x00dead'::bytea::bytea;
+SELECT E'a\\b'::text;
+`)
+
+	_, err := MultiStatement(
+		SELECT(String(string([]byte{0xff}))),
+	).Exec(nil)
+	require.EqualError(t, err, "jet: argument of type string can not be inlined")
+
+	assertPanicErr(t, func() { MultiStatement() }, "jet: multi statement requires at least one statement")
+}
@@ -7,6 +7,9 @@ type Table interface {
 	readableTable
 	writableTable
 	jet.SerializerTable
+
+	// TABLESAMPLE returns table sample of approximately percentage of table rows, selected using sampling method.
+	TABLESAMPLE(method TableSampleMethod, percentage NumericExpression) TableSample
 }
 
 type readableTable interface {
@@ -108,11 +111,20 @@ type tableImpl struct {
 	jet.SerializerTable
 }
 
+func (t *tableImpl) TABLESAMPLE(method TableSampleMethod, percentage NumericExpression) TableSample {
+	return newTableSample(t, method, percentage, nil)
+}
+
 // NewTable creates new table with schema Name, table Name and list of columns
 func NewTable(schemaName, name, alias string, columns ...jet.ColumnExpression) Table {
+	return NewTableWithPrimaryKey(schemaName, name, alias, nil, columns...)
+}
+
+// NewTableWithPrimaryKey creates new table with schema Name, table Name, list of primary key columns and list of columns
+func NewTableWithPrimaryKey(schemaName, name, alias string, primaryKey ColumnList, columns ...jet.ColumnExpression) Table {
 
 	t := &tableImpl{
-		SerializerTable: jet.NewTable(schemaName, name, alias, columns...),
+		SerializerTable: jet.NewTableWithPrimaryKey(schemaName, name, alias, primaryKey, columns...),
 	}
 
 	t.readableTableInterfaceImpl.parent = t
@@ -135,3 +147,52 @@ func newJoinTable(lhs jet.Serializer, rhs jet.Serializer, joinType jet.JoinType,
 
 	return newJoinTable
 }
+
+// TableSampleMethod is table sampling method
+type TableSampleMethod string
+
+// Table sampling methods
+const (
+	// SYSTEM method does block-level sampling, each block having the specified chance of being selected
+	SYSTEM TableSampleMethod = "SYSTEM"
+	// BERNOULLI method selects each row of the table with the specified probability
+	BERNOULLI TableSampleMethod = "BERNOULLI"
+)
+
+// TableSample is readable table returning random sample of the table rows.
+//
+//	SELECT(Film.AllColumns).
+//		FROM(Film.TABLESAMPLE(SYSTEM, Float(1)).REPEATABLE(Int(42)))
+type TableSample interface {
+	ReadableTable
+
+	// REPEATABLE sets random number generator seed, so the same sample is returned each time, as long as the table
+	// has not been changed meanwhile.
+	REPEATABLE(seed NumericExpression) ReadableTable
+}
+
+type tableSampleImpl struct {
+	readableTableInterfaceImpl
+	jet.SerializerTable
+
+	table      Table
+	method     TableSampleMethod
+	percentage NumericExpression
+}
+
+func newTableSample(table Table, method TableSampleMethod, percentage, seed NumericExpression) *tableSampleImpl {
+	newTableSample := &tableSampleImpl{
+		SerializerTable: jet.NewTableSample(table, string(method), percentage, seed),
+		table:           table,
+		method:          method,
+		percentage:      percentage,
+	}
+
+	newTableSample.readableTableInterfaceImpl.parent = newTableSample
+
+	return newTableSample
+}
+
+func (t *tableSampleImpl) REPEATABLE(seed NumericExpression) ReadableTable {
+	return newTableSample(t.table, t.method, t.percentage, seed)
+}
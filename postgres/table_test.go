@@ -122,3 +122,21 @@ FROM db.table1,
      db.table3;
 `)
 }
+
+func TestTABLESAMPLE(t *testing.T) {
+	assertSerialize(t, table1.TABLESAMPLE(SYSTEM, Float(1.5)), `db.table1 TABLESAMPLE SYSTEM ($1)`, 1.5)
+	assertSerialize(t, table1.TABLESAMPLE(BERNOULLI, Int(10)).REPEATABLE(Int(42)),
+		`db.table1 TABLESAMPLE BERNOULLI ($1) REPEATABLE ($2)`, int64(10), int64(42))
+	assertSerializeErr(t, table1.TABLESAMPLE(SYSTEM, nil), "jet: table sample percentage is nil")
+
+	assertStatementSql(t,
+		SELECT(table1ColInt, table2ColInt).
+			FROM(table1.TABLESAMPLE(SYSTEM, Int(1)).REPEATABLE(Int(42)).
+				INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))),
+		`
+SELECT table1.col_int AS "table1.col_int",
+     table2.col_int AS "table2.col_int"
+FROM db.table1 TABLESAMPLE SYSTEM ($1) REPEATABLE ($2)
+     INNER JOIN db.table2 ON (table1.col_int = table2.col_int);
+`, int64(1), int64(42))
+}
@@ -0,0 +1,183 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// TsVectorExpression is representation of postgres text search document (tsvector)
+type TsVectorExpression interface {
+	jet.Expression
+
+	isTsVector()
+
+	EQ(rhs TsVectorExpression) BoolExpression
+	NOT_EQ(rhs TsVectorExpression) BoolExpression
+
+	// MATCH returns true if text search document matches text search query (tsvector @@ tsquery)
+	MATCH(query TsQueryExpression) BoolExpression
+	// CONCAT concatenates text search documents (tsvector || tsvector)
+	CONCAT(rhs TsVectorExpression) TsVectorExpression
+}
+
+type tsVectorInterfaceImpl struct {
+	parent TsVectorExpression
+}
+
+func (t *tsVectorInterfaceImpl) isTsVector() {}
+
+func (t *tsVectorInterfaceImpl) EQ(rhs TsVectorExpression) BoolExpression {
+	return jet.Eq(t.parent, rhs)
+}
+
+func (t *tsVectorInterfaceImpl) NOT_EQ(rhs TsVectorExpression) BoolExpression {
+	return jet.NotEq(t.parent, rhs)
+}
+
+func (t *tsVectorInterfaceImpl) MATCH(query TsQueryExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(t.parent, query, "@@"))
+}
+
+func (t *tsVectorInterfaceImpl) CONCAT(rhs TsVectorExpression) TsVectorExpression {
+	return TsVectorExp(jet.NewBinaryOperatorExpression(t.parent, rhs, "||"))
+}
+
+type tsVectorWrapper struct {
+	tsVectorInterfaceImpl
+	Expression
+}
+
+// TsVectorExp is tsvector expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as tsvector expression.
+// Does not add sql cast to generated sql builder output.
+func TsVectorExp(expression Expression) TsVectorExpression {
+	tsVectorWrap := &tsVectorWrapper{Expression: expression}
+	tsVectorWrap.tsVectorInterfaceImpl.parent = tsVectorWrap
+	return tsVectorWrap
+}
+
+//---------------------------------------------------//
+
+// TsQueryExpression is representation of postgres text search query (tsquery)
+type TsQueryExpression interface {
+	jet.Expression
+
+	isTsQuery()
+
+	EQ(rhs TsQueryExpression) BoolExpression
+	NOT_EQ(rhs TsQueryExpression) BoolExpression
+
+	// MATCH returns true if text search query matches text search document (tsquery @@ tsvector)
+	MATCH(document TsVectorExpression) BoolExpression
+	// AND combines text search queries, both queries have to match (tsquery && tsquery)
+	AND(rhs TsQueryExpression) TsQueryExpression
+	// OR combines text search queries, either of the queries has to match (tsquery || tsquery)
+	OR(rhs TsQueryExpression) TsQueryExpression
+}
+
+type tsQueryInterfaceImpl struct {
+	parent TsQueryExpression
+}
+
+func (t *tsQueryInterfaceImpl) isTsQuery() {}
+
+func (t *tsQueryInterfaceImpl) EQ(rhs TsQueryExpression) BoolExpression {
+	return jet.Eq(t.parent, rhs)
+}
+
+func (t *tsQueryInterfaceImpl) NOT_EQ(rhs TsQueryExpression) BoolExpression {
+	return jet.NotEq(t.parent, rhs)
+}
+
+func (t *tsQueryInterfaceImpl) MATCH(document TsVectorExpression) BoolExpression {
+	return BoolExp(jet.NewBinaryOperatorExpression(t.parent, document, "@@"))
+}
+
+func (t *tsQueryInterfaceImpl) AND(rhs TsQueryExpression) TsQueryExpression {
+	return TsQueryExp(jet.NewBinaryOperatorExpression(t.parent, rhs, "&&"))
+}
+
+func (t *tsQueryInterfaceImpl) OR(rhs TsQueryExpression) TsQueryExpression {
+	return TsQueryExp(jet.NewBinaryOperatorExpression(t.parent, rhs, "||"))
+}
+
+type tsQueryWrapper struct {
+	tsQueryInterfaceImpl
+	Expression
+}
+
+// TsQueryExp is tsquery expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as tsquery expression.
+// Does not add sql cast to generated sql builder output.
+func TsQueryExp(expression Expression) TsQueryExpression {
+	tsQueryWrap := &tsQueryWrapper{Expression: expression}
+	tsQueryWrap.tsQueryInterfaceImpl.parent = tsQueryWrap
+	return tsQueryWrap
+}
+
+//------------------ Text search functions ------------------//
+
+// TO_TSVECTOR converts document text to text search document. Optional config is the name of text search
+// configuration (for instance "english"), if omitted default_text_search_config is used.
+//
+//	TO_TSVECTOR(Film.Description, "english")
+func TO_TSVECTOR(document StringExpression, config ...string) TsVectorExpression {
+	return TsVectorExp(jet.Func("to_tsvector", textSearchArgs(config, document)...))
+}
+
+// TO_TSQUERY converts query text, consisting of tokens separated by tsquery operators (&, |, !, <->), to text search
+// query. Optional config is the name of text search configuration.
+func TO_TSQUERY(query StringExpression, config ...string) TsQueryExpression {
+	return TsQueryExp(jet.Func("to_tsquery", textSearchArgs(config, query)...))
+}
+
+// PLAINTO_TSQUERY converts unformatted query text to text search query, where all the words have to match.
+// Optional config is the name of text search configuration.
+func PLAINTO_TSQUERY(query StringExpression, config ...string) TsQueryExpression {
+	return TsQueryExp(jet.Func("plainto_tsquery", textSearchArgs(config, query)...))
+}
+
+// WEBSEARCH_TO_TSQUERY converts query text in web search syntax (quoted phrases, OR, - for negation) to text
+// search query. Optional config is the name of text search configuration.
+func WEBSEARCH_TO_TSQUERY(query StringExpression, config ...string) TsQueryExpression {
+	return TsQueryExp(jet.Func("websearch_to_tsquery", textSearchArgs(config, query)...))
+}
+
+// TS_RANK ranks text search document for a query, based on the frequency of matching lexemes.
+// Optional normalization specifies how document length impacts the rank.
+func TS_RANK(document TsVectorExpression, query TsQueryExpression, normalization ...IntegerExpression) FloatExpression {
+	return FloatExp(jet.Func("ts_rank", textSearchRankArgs(document, query, normalization)...))
+}
+
+// TS_RANK_CD ranks text search document for a query using cover density ranking, which also takes
+// proximity of matching lexemes into account. Optional normalization specifies how document length impacts the rank.
+func TS_RANK_CD(document TsVectorExpression, query TsQueryExpression, normalization ...IntegerExpression) FloatExpression {
+	return FloatExp(jet.Func("ts_rank_cd", textSearchRankArgs(document, query, normalization)...))
+}
+
+// TS_HEADLINE returns an excerpt of the document with query terms highlighted. Optional options is a comma separated
+// list of option=value pairs, for instance String("StartSel=<b>, StopSel=</b>").
+func TS_HEADLINE(document StringExpression, query TsQueryExpression, options ...StringExpression) StringExpression {
+	args := []Expression{document, query}
+
+	if len(options) > 0 {
+		args = append(args, options[0])
+	}
+
+	return StringExp(jet.Func("ts_headline", args...))
+}
+
+func textSearchArgs(config []string, text StringExpression) []Expression {
+	if len(config) > 0 {
+		return []Expression{CAST(jet.String(config[0])).AS("regconfig"), text}
+	}
+
+	return []Expression{text}
+}
+
+func textSearchRankArgs(document TsVectorExpression, query TsQueryExpression, normalization []IntegerExpression) []Expression {
+	args := []Expression{document, query}
+
+	if len(normalization) > 0 {
+		args = append(args, normalization[0])
+	}
+
+	return args
+}
@@ -0,0 +1,69 @@
+package postgres
+
+import "testing"
+
+var (
+	documentColTitle  = StringColumn("title")
+	documentColSearch = TsVectorColumn("search")
+	documentColQuery  = TsQueryColumn("query")
+	documentTable     = NewTable("db", "document", "", documentColTitle, documentColSearch, documentColQuery)
+)
+
+func TestTsVectorExpression(t *testing.T) {
+	assertSerialize(t, documentColSearch, "document.search")
+	assertSerialize(t, documentColSearch.EQ(documentColSearch), "(document.search = document.search)")
+	assertSerialize(t, documentColSearch.NOT_EQ(TO_TSVECTOR(documentColTitle)),
+		"(document.search != to_tsvector(document.title))")
+	assertSerialize(t, documentColSearch.MATCH(documentColQuery), "(document.search @@ document.query)")
+	assertSerialize(t, documentColSearch.CONCAT(TO_TSVECTOR(documentColTitle, "simple")),
+		"(document.search || to_tsvector($1::regconfig, document.title))", "simple")
+	assertSerialize(t, CAST(String("fat cat")).AS_TSVECTOR().MATCH(documentColQuery),
+		"($1::text::tsvector @@ document.query)", "fat cat")
+}
+
+func TestTsQueryExpression(t *testing.T) {
+	assertSerialize(t, documentColQuery.MATCH(documentColSearch), "(document.query @@ document.search)")
+	assertSerialize(t, documentColQuery.AND(TO_TSQUERY(String("fat & rat"))),
+		"(document.query && to_tsquery($1::text))", "fat & rat")
+	assertSerialize(t, PLAINTO_TSQUERY(String("fat rat"), "english").OR(WEBSEARCH_TO_TSQUERY(String(`"fat rat" -cat`))),
+		"(plainto_tsquery($1::regconfig, $2::text) || websearch_to_tsquery($3::text))", "english", "fat rat", `"fat rat" -cat`)
+	assertSerialize(t, CAST(String("fat & rat")).AS_TSQUERY().EQ(documentColQuery),
+		"($1::text::tsquery = document.query)", "fat & rat")
+}
+
+func TestTextSearchFunctions(t *testing.T) {
+	query := WEBSEARCH_TO_TSQUERY(String("rat"), "english")
+
+	assertSerialize(t, TS_RANK(documentColSearch, query),
+		"ts_rank(document.search, websearch_to_tsquery($1::regconfig, $2::text))", "english", "rat")
+	assertSerialize(t, TS_RANK_CD(documentColSearch, query, Int(32)),
+		"ts_rank_cd(document.search, websearch_to_tsquery($1::regconfig, $2::text), $3)", "english", "rat", int64(32))
+	assertSerialize(t, TS_HEADLINE(documentColTitle, query),
+		"ts_headline(document.title, websearch_to_tsquery($1::regconfig, $2::text))", "english", "rat")
+	assertSerialize(t, TS_HEADLINE(documentColTitle, query, String("StartSel=<b>, StopSel=</b>")),
+		"ts_headline(document.title, websearch_to_tsquery($1::regconfig, $2::text), $3::text)",
+		"english", "rat", "StartSel=<b>, StopSel=</b>")
+}
+
+func TestTextSearchStatement(t *testing.T) {
+	query := WEBSEARCH_TO_TSQUERY(String("fat rat"), "english")
+
+	stmt := SELECT(
+		documentColTitle,
+		TS_RANK(documentColSearch, query).AS("rank"),
+	).FROM(
+		documentTable,
+	).WHERE(
+		documentColSearch.MATCH(query),
+	).ORDER_BY(
+		TS_RANK(documentColSearch, query).DESC(),
+	)
+
+	assertDebugStatementSql(t, stmt, `
+SELECT document.title AS "document.title",
+     ts_rank(document.search, websearch_to_tsquery('english'::regconfig, 'fat rat'::text)) AS "rank"
+FROM db.document
+WHERE document.search @@ websearch_to_tsquery('english'::regconfig, 'fat rat'::text)
+ORDER BY ts_rank(document.search, websearch_to_tsquery('english'::regconfig, 'fat rat'::text)) DESC;
+`)
+}
@@ -1,19 +1,37 @@
 package postgres
 
 import (
+	"context"
+	"database/sql"
+
 	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
 )
 
+// BeforeUpdateHook can be implemented by model types to modify model before it is used by UPDATE statement MODEL
+// and MODELS methods. Hook is called on statement execution, on a copy of the model taken by MODEL or MODELS,
+// and error returned from BeforeUpdate is returned from the execution. Sql and DebugSql do not call the hook.
+type BeforeUpdateHook = jet.BeforeUpdateHook
+
 // UpdateStatement is interface of SQL UPDATE statement
 type UpdateStatement interface {
 	jet.SerializerStatement
 
 	SET(value interface{}, values ...interface{}) UpdateStatement
 	MODEL(data interface{}) UpdateStatement
+	// MODELS updates table rows with the values of the list of models. Rows are matched on the table primary key
+	// columns, which are also extracted from the models. If data is not a slice of structures or table does not
+	// have primary key columns, this method will panic.
+	MODELS(data interface{}) UpdateStatement
 
 	FROM(tables ...ReadableTable) UpdateStatement
 	WHERE(expression BoolExpression) UpdateStatement
 	RETURNING(projections ...Projection) UpdateStatement
+
+	// ExecInBatches executes statement over db connection/transaction as a sequence of statements, each containing
+	// a subset of MODELS rows, so that no statement exceeds PostgreSQL limit on the number of parametrized arguments.
+	// Returned result contains the total number of rows affected.
+	ExecInBatches(ctx context.Context, db qrm.Executable) (sql.Result, error)
 }
 
 type updateStatementImpl struct {
@@ -25,6 +43,8 @@ type updateStatementImpl struct {
 	From      jet.ClauseFrom
 	Where     jet.ClauseWhere
 	Returning jet.ClauseReturning
+
+	Models clauseUpdateModels
 }
 
 func newUpdateStatement(table WritableTable, columns []jet.Column) UpdateStatement {
@@ -60,10 +80,28 @@ func (u *updateStatementImpl) SET(value interface{}, values ...interface{}) Upda
 }
 
 func (u *updateStatementImpl) MODEL(data interface{}) UpdateStatement {
-	u.Set.Values = jet.UnwindRowFromModel(u.Set.Columns, data)
+	u.Set.Values = jet.UnwindRowFromModel(jet.UpdateStatementType, u.Set.Columns, data)
 	return u
 }
 
+func (u *updateStatementImpl) MODELS(data interface{}) UpdateStatement {
+	u.setModels(jet.NewUpdateModels(u.Update.Table, u.Set.Columns, data))
+	return u
+}
+
+func (u *updateStatementImpl) setModels(models *jet.UpdateModels) {
+	u.Models = clauseUpdateModels{
+		UpdateModels: models,
+		From:         &u.From,
+		Where:        &u.Where,
+	}
+
+	u.SerializerStatement = jet.NewStatementImpl(Dialect, jet.UpdateStatementType, u,
+		&u.Update,
+		&u.Models,
+		&u.Returning)
+}
+
 func (u *updateStatementImpl) FROM(tables ...ReadableTable) UpdateStatement {
 	u.From.Tables = readableTablesToSerializerList(tables)
 	return u
@@ -79,6 +117,25 @@ func (u *updateStatementImpl) RETURNING(projections ...jet.Projection) UpdateSta
 	return u
 }
 
+func (u *updateStatementImpl) ExecInBatches(ctx context.Context, db qrm.Executable) (sql.Result, error) {
+	if u.Models.UpdateModels == nil {
+		return u.ExecContext(ctx, db)
+	}
+
+	return jet.ExecInBatches(ctx, db, Dialect, maxArgumentsPerStatement, u.Models.Rows, u.batchStatement)
+}
+
+func (u *updateStatementImpl) batchStatement(rows [][]jet.Serializer) Statement {
+	batch := newUpdateStatement(nil, nil).(*updateStatementImpl)
+	batch.Update = u.Update
+	batch.From = u.From
+	batch.Where = u.Where
+	batch.Returning = u.Returning
+	batch.setModels(u.Models.WithRows(rows))
+
+	return batch
+}
+
 type clauseSet struct {
 	Columns []jet.Column
 	Values  []jet.Serializer
@@ -117,3 +174,84 @@ func (s *clauseSet) Serialize(statementType jet.StatementType, out *jet.SQLBuild
 		out.WriteString(")")
 	}
 }
+
+// clauseUpdateModels serializes SET, FROM and WHERE clauses of UPDATE MODELS statement:
+//
+//	SET col = v.col
+//	FROM (VALUES ...) AS v (pk, col)
+//	WHERE table.pk = v.pk
+//
+// VALUES parameters would be resolved as text by PostgreSQL, so the first row of VALUES list is a row of typed NULLs,
+// taken from the table row type, from which the types of other rows are inferred. NULL row never matches primary key.
+type clauseUpdateModels struct {
+	*jet.UpdateModels
+	From  *jet.ClauseFrom
+	Where *jet.ClauseWhere
+}
+
+func (m *clauseUpdateModels) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	out.NewLine()
+	out.WriteString("SET")
+	m.SerializeAssignments(out, false)
+
+	out.NewLine()
+	out.WriteString("FROM")
+	out.IncreaseIdent()
+	out.WriteString("(")
+	out.IncreaseIdent()
+	m.serializeValues(statementType, out)
+	out.DecreaseIdent()
+	out.NewLine()
+	out.WriteString(")")
+	out.WriteString("AS")
+	out.WriteIdentifier(jet.UpdateModelsAlias)
+	out.WriteString("(")
+	jet.SerializeColumnNames(m.ValueColumns(), out)
+	out.WriteString(")")
+
+	for _, table := range m.From.Tables {
+		out.WriteString(",")
+		out.NewLine()
+		jet.Serialize(table, statementType, out, jet.FallTrough(options)...)
+	}
+	out.DecreaseIdent()
+
+	out.NewLine()
+	out.WriteString("WHERE")
+	out.IncreaseIdent(6)
+	m.SerializeCondition(statementType, out, m.Where.Condition)
+	out.DecreaseIdent(6)
+}
+
+func (m *clauseUpdateModels) serializeValues(statementType jet.StatementType, out *jet.SQLBuilder) {
+	out.NewLine()
+	out.WriteString("VALUES")
+	out.IncreaseIdent(7)
+
+	out.WriteString("(")
+	for i, column := range m.ValueColumns() {
+		if i > 0 {
+			out.WriteString(", ")
+		}
+
+		out.WriteString("(NULL::")
+		if m.Table.SchemaName() != "" {
+			out.WriteIdentifier(m.Table.SchemaName())
+			out.WriteString(".")
+		}
+		out.WriteIdentifier(m.Table.TableName())
+		out.WriteString(").")
+		out.WriteIdentifier(column.Name())
+	}
+	out.WriteString(")")
+
+	for _, row := range m.Rows {
+		out.WriteString(",")
+		out.NewLine()
+		out.WriteString("(")
+		jet.SerializeClauseList(statementType, row, out)
+		out.WriteString(")")
+	}
+
+	out.DecreaseIdent(7)
+}
@@ -1,8 +1,11 @@
 package postgres
 
 import (
+	"context"
 	"fmt"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestUpdateWithOneValue(t *testing.T) {
@@ -60,3 +63,73 @@ func TestInvalidInputs(t *testing.T) {
 	assertStatementSqlErr(t, table1.UPDATE(table1ColInt).SET(1), "jet: WHERE clause not set")
 	assertStatementSqlErr(t, table1.UPDATE(nil).SET(1), "jet: nil column in columns list")
 }
+
+var table1WithPK = NewTableWithPrimaryKey("db", "table1", "", ColumnList{table1Col1}, table1Col1, table1ColInt, table1ColFloat)
+
+type updateModel struct {
+	Col1     int
+	ColInt   int
+	ColFloat float64
+}
+
+func TestUpdateModels(t *testing.T) {
+	expectedSQL := `
+UPDATE db.table1
+SET col_int = v.col_int,
+    col_float = v.col_float
+FROM (
+          VALUES ((NULL::db.table1).col1, (NULL::db.table1).col_int, (NULL::db.table1).col_float),
+                 ($1, $2, $3),
+                 ($4, $5, $6)
+     ) AS v (col1, col_int, col_float),
+     db.table2
+WHERE table1.col1 = v.col1 AND (table2.col_int = (v.col_int))
+RETURNING table1.col1 AS "table1.col1";
+`
+	stmt := table1WithPK.UPDATE(table1Col1, table1ColInt, table1ColFloat).
+		MODELS([]updateModel{
+			{Col1: 1, ColInt: 10, ColFloat: 1.1},
+			{Col1: 2, ColInt: 20, ColFloat: 2.2},
+		}).
+		FROM(table2).
+		WHERE(table2ColInt.EQ(IntExp(Raw("v.col_int")))).
+		RETURNING(table1Col1)
+
+	assertStatementSql(t, stmt, expectedSQL, 1, 10, 1.1, 2, 20, 2.2)
+}
+
+func TestUpdateModelsInvalidInputs(t *testing.T) {
+	require.PanicsWithValue(t, "jet: UPDATE MODELS requires table with primary key columns", func() {
+		table1.UPDATE(table1ColInt).MODELS([]updateModel{})
+	})
+	require.PanicsWithValue(t, "jet: no columns selected", func() {
+		table1WithPK.UPDATE(table1Col1).MODELS([]updateModel{})
+	})
+}
+
+func TestUpdateModels_ExecInBatches(t *testing.T) {
+	var models []updateModel
+
+	for i := 0; i < 30000; i++ {
+		models = append(models, updateModel{Col1: i})
+	}
+
+	stmt := table1WithPK.UPDATE(table1ColInt, table1ColFloat).
+		MODELS(models)
+
+	db := &execRecorder{}
+
+	res, err := stmt.ExecInBatches(context.Background(), db)
+	require.NoError(t, err)
+
+	rowsAffected, err := res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(30000), rowsAffected)
+
+	require.Len(t, db.queries, 2)
+	require.Len(t, db.args[0], 65535)
+	require.Len(t, db.args[1], 90000-65535)
+	require.Contains(t, db.queries[1], "VALUES ((NULL::db.table1).col1, (NULL::db.table1).col_int, (NULL::db.table1).col_float),")
+	require.Contains(t, db.queries[1], "($1, $2, $3),")
+	require.Contains(t, db.queries[1], "WHERE table1.col1 = v.col1;")
+}
@@ -0,0 +1,157 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+type values struct {
+	rows        []Expression
+	models      interface{}
+	columnTypes []string
+}
+
+// VALUES creates table source from the list of rows constructed with ROW or WRAP. Table alias and column
+// names are set with AS method, and table columns can be referenced with column From method.
+//
+//	id, code := IntegerColumn("id"), StringColumn("code")
+//	codes := VALUES(
+//		WRAP(Int32(1), String("a")),
+//		WRAP(Int32(2), String("b")),
+//	).AS("codes", id, code)
+//
+//	SELECT(Film.AllColumns, code.From(codes)).
+//		FROM(Film.INNER_JOIN(codes, Film.FilmID.EQ(id.From(codes))))
+func VALUES(rows ...Expression) values {
+	return values{rows: rows}
+}
+
+// MODELS adds one row for each struct from data slice. Row values are struct fields matching table columns
+// set with AS method, in the same way as for INSERT statement MODELS. If VALUES has no rows because data slice
+// is empty, statement using VALUES table returns an error on execution, so callers should check the slice length
+// before building the statement.
+func (v values) MODELS(data interface{}) values {
+	v.models = data
+	return v
+}
+
+// COLUMN_TYPES sets SQL types of the VALUES table columns, literal row values are cast to. For instance:
+//
+//	VALUES().MODELS(codes).COLUMN_TYPES("integer", "text").AS("codes", id, code)
+//
+// Without COLUMN_TYPES, literal values are cast to the type matching Go type of the column values (integer for
+// int32, real for float32, etc.), or to the type of the column expression if column has only NULL values.
+// Values that are not literals, like Int32(1) or column expressions, are not cast.
+func (v values) COLUMN_TYPES(types ...string) values {
+	v.columnTypes = types
+	return v
+}
+
+// AS sets VALUES table alias name and the list of columns
+func (v values) AS(alias string, columns ...jet.ColumnExpression) SelectTable {
+	rows := jet.UnwindValuesRows(v.rows, v.models, columns)
+
+	for i := range columns {
+		castType := v.columnCastType(rows, i, columns[i])
+
+		for _, row := range rows {
+			if i < len(row) {
+				row[i] = valuesCast(row[i], castType)
+			}
+		}
+	}
+
+	valuesTable := &selectTableImpl{
+		SelectTable: jet.NewValuesTable(jet.ValuesTableOptions{}, rows, alias, columns),
+	}
+
+	valuesTable.readableTableInterfaceImpl.parent = valuesTable
+
+	return valuesTable
+}
+
+// valuesCast adds explicit cast of literal values to the type of the table column, because postgres
+// resolves the type of untyped VALUES parameters as text.
+func valuesCast(value jet.Serializer, castType string) jet.Serializer {
+	literal, ok := value.(jet.LiteralExpression)
+
+	if !ok || castType == "" {
+		return value
+	}
+
+	return CAST(literal).AS(castType)
+}
+
+// columnCastType returns the type literal values of the column at index are cast to
+func (v values) columnCastType(rows [][]jet.Serializer, index int, column jet.ColumnExpression) string {
+	if index < len(v.columnTypes) {
+		return v.columnTypes[index]
+	}
+
+	for _, row := range rows {
+		if index >= len(row) {
+			continue
+		}
+
+		literal, ok := row[index].(jet.LiteralExpression)
+
+		if !ok || literal.Value() == nil {
+			continue
+		}
+
+		if castType := valueCastType(literal.Value()); castType != "" {
+			return castType
+		}
+
+		break
+	}
+
+	return columnExpressionCastType(column)
+}
+
+func valueCastType(value interface{}) string {
+	switch value.(type) {
+	case bool:
+		return "boolean"
+	case int8, uint8, int16:
+		return "smallint"
+	case uint16, int32:
+		return "integer"
+	case int, int64, uint, uint32, uint64:
+		return "bigint"
+	case float32:
+		return "real"
+	case float64:
+		return "double precision"
+	case string:
+		return "text"
+	case []byte:
+		return "bytea"
+	}
+
+	return ""
+}
+
+func columnExpressionCastType(column jet.ColumnExpression) string {
+	switch column.(type) {
+	case BoolExpression:
+		return "boolean"
+	case IntegerExpression:
+		return "bigint"
+	case FloatExpression:
+		return "numeric"
+	case StringExpression:
+		return "text"
+	case DateExpression:
+		return "date"
+	case TimestampExpression:
+		return "timestamp without time zone"
+	case TimestampzExpression:
+		return "timestamp with time zone"
+	case TimeExpression:
+		return "time without time zone"
+	case TimezExpression:
+		return "time with time zone"
+	case IntervalExpression:
+		return "interval"
+	}
+
+	return ""
+}
@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestValuesTable(t *testing.T) {
+	id, code := IntegerColumn("id"), StringColumn("code")
+
+	codes := VALUES(
+		WRAP(Int(1), String("a")),
+		ROW(Int32(2), String("b")),
+	).AS("codes", id, code)
+
+	stmt := SELECT(
+		table1ColFloat, code.From(codes),
+	).FROM(
+		table1.INNER_JOIN(codes, table1ColInt.EQ(id.From(codes))),
+	)
+
+	assertStatementSql(t, stmt, `
+SELECT table1.col_float AS "table1.col_float",
+     codes.code AS "code"
+FROM db.table1
+     INNER JOIN (
+          VALUES ($1::bigint, $2::text),
+                 ($3::integer, $4::text)
+     ) AS codes (id, code) ON (table1.col_int = codes.id);
+`, int64(1), "a", int32(2), "b")
+}
+
+func TestValuesTableAllColumns(t *testing.T) {
+	id, code := IntegerColumn("id"), StringColumn("code")
+
+	codes := VALUES(WRAP(Int(1), String("a"))).AS("codes", id, code)
+
+	assertStatementSql(t, SELECT(codes.AllColumns()).FROM(codes), `
+SELECT codes.id AS "id",
+     codes.code AS "code"
+FROM (
+          VALUES ($1::bigint, $2::text)
+     ) AS codes (id, code);
+`, int64(1), "a")
+}
+
+func TestValuesTableModels(t *testing.T) {
+	type Code struct {
+		ID    int32
+		Code  string
+		Score *float64
+	}
+
+	score := 1.5
+
+	id, code, scoreColumn := IntegerColumn("id"), StringColumn("code"), FloatColumn("score")
+
+	codes := VALUES().MODELS([]Code{
+		{ID: 1, Code: "a", Score: &score},
+		{ID: 2, Code: "b"},
+	}).AS("codes", id, code, scoreColumn)
+
+	assertStatementSql(t, SELECT(codes.AllColumns()).FROM(codes), `
+SELECT codes.id AS "id",
+     codes.code AS "code",
+     codes.score AS "score"
+FROM (
+          VALUES ($1::integer, $2::text, $3::double precision),
+                 ($4::integer, $5::text, $6::double precision)
+     ) AS codes (id, code, score);
+`, int32(1), "a", 1.5, int32(2), "b", nil)
+
+	codes = VALUES().MODELS([]Code{
+		{ID: 1, Code: "a"},
+	}).COLUMN_TYPES("smallint", "varchar(10)", "real").AS("codes", id, code, scoreColumn)
+
+	assertStatementSql(t, SELECT(codes.AllColumns()).FROM(codes), `
+SELECT codes.id AS "id",
+     codes.code AS "code",
+     codes.score AS "score"
+FROM (
+          VALUES ($1::smallint, $2::varchar(10), $3::real)
+     ) AS codes (id, code, score);
+`, int32(1), "a", nil)
+
+	codes = VALUES().MODELS([]Code{
+		{ID: 1, Code: "a"},
+	}).AS("codes", id, code, scoreColumn)
+
+	assertStatementSql(t, SELECT(codes.AllColumns()).FROM(codes), `
+SELECT codes.id AS "id",
+     codes.code AS "code",
+     codes.score AS "score"
+FROM (
+          VALUES ($1::integer, $2::text, $3::numeric)
+     ) AS codes (id, code, score);
+`, int32(1), "a", nil)
+}
+
+func TestValuesTableInvalid(t *testing.T) {
+	id, code := IntegerColumn("id"), StringColumn("code")
+
+	assertPanicErr(t, func() {
+		VALUES(WRAP(Int(1))).AS("codes", id, code)
+	}, "jet: VALUES row 1 has 1 values, expected 2")
+
+	assertPanicErr(t, func() {
+		VALUES().AS("codes", id)
+	}, "jet: VALUES table requires at least one row")
+
+	assertPanicErr(t, func() {
+		VALUES(Int(1)).AS("codes", id)
+	}, "jet: VALUES row has to be constructed with ROW or WRAP")
+}
+
+func TestValuesTableEmptyModels(t *testing.T) {
+	id := IntegerColumn("id")
+
+	type Code struct {
+		ID int64
+	}
+
+	codes := VALUES().MODELS([]Code{}).AS("codes", id)
+
+	stmt := SELECT(id.From(codes)).FROM(codes)
+
+	_, err := stmt.Exec(nil)
+	require.EqualError(t, err, "jet: VALUES table has no rows, models slice is empty")
+}
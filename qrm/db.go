@@ -23,3 +23,14 @@ type Queryable interface {
 type Executable interface {
 	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
 }
+
+// RowSource is a source of query result rows. It is implemented by *sql.Rows, and it can be implemented by adapters
+// of the drivers not based on database/sql (for instance pgx), so that query result mapping can scan their rows natively.
+// Scan is always called with a list of *interface{} destinations, one for each column.
+type RowSource interface {
+	Columns() ([]string, error)
+	Next() bool
+	Scan(dest ...interface{}) error
+	Err() error
+	Close() error
+}
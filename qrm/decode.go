@@ -0,0 +1,318 @@
+package qrm
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// fieldDecode is the kind of decoding applied to column value before it is stored into destination field
+type fieldDecode int
+
+const (
+	noDecode    fieldDecode = iota
+	jsonDecode              // `sql:"json"` - column value is JSON document
+	arrayDecode             // `sql:"array"` - column value is PostgreSQL array literal or JSON array
+)
+
+func getFieldDecode(field reflect.StructField) fieldDecode {
+	options := sqlTagOptions(field)
+
+	if _, ok := options["json"]; ok {
+		return jsonDecode
+	}
+
+	if _, ok := options["array"]; ok {
+		return arrayDecode
+	}
+
+	return noDecode
+}
+
+func (d fieldDecode) String() string {
+	if d == jsonDecode {
+		return "json"
+	}
+
+	return "array"
+}
+
+// decodeValue decodes JSON document or array literal from the source value ([]byte or string) into destination.
+// Array source value already decoded into Go slice is assigned element by element.
+func decodeValue(decode fieldDecode, source, destination reflect.Value) error {
+	var data []byte
+
+	switch value := source.Interface().(type) {
+	case []byte:
+		data = value
+	case string:
+		data = []byte(value)
+	default:
+		if decode == arrayDecode && source.Kind() == reflect.Slice {
+			return assignSlice(source, destination)
+		}
+
+		return fmt.Errorf("expected []byte or string, got %T", value)
+	}
+
+	if decode == jsonDecode || isJSONArray(data) {
+		destinationPtr := reflect.New(destination.Type())
+
+		if err := json.Unmarshal(data, destinationPtr.Interface()); err != nil {
+			return err
+		}
+
+		destination.Set(destinationPtr.Elem())
+
+		return nil
+	}
+
+	elements, err := parseArrayLiteral(string(data))
+
+	if err != nil {
+		return err
+	}
+
+	return assignArray(elements, destination)
+}
+
+// arrayDimensions matches optional dimensions decoration of PostgreSQL array literal, for instance [0:1]= or [1:2][1:3]=
+var arrayDimensions = regexp.MustCompile(`^(\[-?\d+:-?\d+\])+=`)
+
+// isJSONArray returns true if data is JSON array, and not PostgreSQL array literal with dimensions decoration
+func isJSONArray(data []byte) bool {
+	data = bytes.TrimSpace(data)
+
+	return bytes.HasPrefix(data, []byte("[")) && !arrayDimensions.Match(data)
+}
+
+func assignArray(elements []arrayElement, destination reflect.Value) error {
+	if destination.Kind() == reflect.Ptr {
+		if destination.IsNil() {
+			destination.Set(reflect.New(destination.Type().Elem()))
+		}
+
+		destination = destination.Elem()
+	}
+
+	if destination.Kind() != reflect.Slice {
+		return fmt.Errorf("can't assign array to %s", destination.Type().String())
+	}
+
+	slice := reflect.MakeSlice(destination.Type(), len(elements), len(elements))
+
+	for i, element := range elements {
+		var err error
+
+		if element.isArray {
+			err = assignArray(element.elements, slice.Index(i))
+		} else if !element.isNull {
+			err = assignArrayElement(element.value, slice.Index(i))
+		}
+
+		if err != nil {
+			return fmt.Errorf("array element %d: %w", i, err)
+		}
+	}
+
+	destination.Set(slice)
+
+	return nil
+}
+
+func assignSlice(source, destination reflect.Value) error {
+	if destination.Kind() == reflect.Ptr {
+		if destination.IsNil() {
+			destination.Set(reflect.New(destination.Type().Elem()))
+		}
+
+		destination = destination.Elem()
+	}
+
+	if destination.Kind() != reflect.Slice {
+		return fmt.Errorf("can't assign array to %s", destination.Type().String())
+	}
+
+	slice := reflect.MakeSlice(destination.Type(), source.Len(), source.Len())
+
+	for i := 0; i < source.Len(); i++ {
+		if err := assign(source.Index(i), slice.Index(i)); err != nil {
+			return fmt.Errorf("array element %d: %w", i, err)
+		}
+	}
+
+	destination.Set(slice)
+
+	return nil
+}
+
+func assignArrayElement(value string, destination reflect.Value) error {
+	if destination.Kind() == reflect.Ptr {
+		if destination.IsNil() {
+			destination.Set(reflect.New(destination.Type().Elem()))
+		}
+
+		destination = destination.Elem()
+	}
+
+	if scanner, ok := destination.Addr().Interface().(sql.Scanner); ok {
+		return scanner.Scan(value)
+	}
+
+	return assign(reflect.ValueOf(value), destination)
+}
+
+type arrayElement struct {
+	value    string
+	isNull   bool
+	isArray  bool
+	elements []arrayElement
+}
+
+var errArrayLiteralEnd = errors.New("unexpected end of array literal")
+
+// parseArrayLiteral parses PostgreSQL array literal, for instance {1,2,NULL}, {"a b","c\"d"} or {{1,2},{3,4}}
+func parseArrayLiteral(literal string) ([]arrayElement, error) {
+	parser := arrayParser{input: literal}
+
+	// optional dimensions decoration, for instance [0:1]={1,2}
+	if strings.HasPrefix(literal, "[") {
+		if index := strings.Index(literal, "="); index > 0 {
+			parser.pos = index + 1
+		}
+	}
+
+	parser.skipSpaces()
+
+	elements, err := parser.parseArray()
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid array literal %q: %w", literal, err)
+	}
+
+	parser.skipSpaces()
+
+	if !parser.eof() {
+		return nil, fmt.Errorf("invalid array literal %q: unexpected character at position %d", literal, parser.pos)
+	}
+
+	return elements, nil
+}
+
+type arrayParser struct {
+	input string
+	pos   int
+}
+
+func (p *arrayParser) eof() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *arrayParser) skipSpaces() {
+	for !p.eof() && (p.input[p.pos] == ' ' || p.input[p.pos] == '\t' || p.input[p.pos] == '\n') {
+		p.pos++
+	}
+}
+
+func (p *arrayParser) parseArray() ([]arrayElement, error) {
+	if p.eof() {
+		return nil, errArrayLiteralEnd
+	}
+
+	if p.input[p.pos] != '{' {
+		return nil, fmt.Errorf("expected '{' at position %d", p.pos)
+	}
+
+	p.pos++
+	p.skipSpaces()
+
+	elements := []arrayElement{}
+
+	if !p.eof() && p.input[p.pos] == '}' {
+		p.pos++
+		return elements, nil
+	}
+
+	for {
+		p.skipSpaces()
+
+		if p.eof() {
+			return nil, errArrayLiteralEnd
+		}
+
+		var element arrayElement
+		var err error
+
+		switch p.input[p.pos] {
+		case '{':
+			element.isArray = true
+			element.elements, err = p.parseArray()
+		case '"':
+			element.value, err = p.parseQuoted()
+		default:
+			element.value = p.parseUnquoted()
+			element.isNull = strings.EqualFold(element.value, "NULL")
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		elements = append(elements, element)
+
+		p.skipSpaces()
+
+		if p.eof() {
+			return nil, errArrayLiteralEnd
+		}
+
+		switch p.input[p.pos] {
+		case ',':
+			p.pos++
+		case '}':
+			p.pos++
+			return elements, nil
+		default:
+			return nil, fmt.Errorf("expected ',' or '}' at position %d", p.pos)
+		}
+	}
+}
+
+func (p *arrayParser) parseQuoted() (string, error) {
+	var value strings.Builder
+
+	for p.pos++; !p.eof(); p.pos++ {
+		switch ch := p.input[p.pos]; ch {
+		case '\\':
+			p.pos++
+
+			if p.eof() {
+				return "", errArrayLiteralEnd
+			}
+
+			value.WriteByte(p.input[p.pos])
+		case '"':
+			p.pos++
+			return value.String(), nil
+		default:
+			value.WriteByte(ch)
+		}
+	}
+
+	return "", errArrayLiteralEnd
+}
+
+func (p *arrayParser) parseUnquoted() string {
+	start := p.pos
+
+	for !p.eof() && p.input[p.pos] != ',' && p.input[p.pos] != '}' {
+		p.pos++
+	}
+
+	return strings.TrimSpace(p.input[start:p.pos])
+}
@@ -0,0 +1,165 @@
+package qrm
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseArrayLiteral(t *testing.T) {
+	elements, err := parseArrayLiteral(`{1, "a b" ,"c\"d\\",NULL,"NULL",{}}`)
+	require.NoError(t, err)
+	require.Equal(t, []arrayElement{
+		{value: "1"},
+		{value: "a b"},
+		{value: `c"d\`},
+		{value: "NULL", isNull: true},
+		{value: "NULL"},
+		{isArray: true, elements: []arrayElement{}},
+	}, elements)
+
+	elements, err = parseArrayLiteral(`[0:1]={{1,2},{3,4}}`)
+	require.NoError(t, err)
+	require.Len(t, elements, 2)
+	require.Equal(t, []arrayElement{{value: "3"}, {value: "4"}}, elements[1].elements)
+
+	_, err = parseArrayLiteral(`{1,2`)
+	require.EqualError(t, err, `invalid array literal "{1,2": unexpected end of array literal`)
+
+	_, err = parseArrayLiteral(`{1,"2}`)
+	require.EqualError(t, err, `invalid array literal "{1,\"2}": unexpected end of array literal`)
+
+	_, err = parseArrayLiteral(`{1,2}3`)
+	require.EqualError(t, err, `invalid array literal "{1,2}3": unexpected character at position 5`)
+
+	_, err = parseArrayLiteral(`1,2`)
+	require.EqualError(t, err, `invalid array literal "1,2": expected '{' at position 0`)
+}
+
+func TestDecodeJSONAndArrayFields(t *testing.T) {
+	db := openMemoryDB(t)
+
+	type Address struct {
+		City    string `json:"city"`
+		ZipCode int    `json:"zip"`
+	}
+
+	type Customer struct {
+		ID       int64                  `sql:"primary_key"`
+		Address  Address                `sql:"json"`
+		Previous *Address               `sql:"json"`
+		Tags     []string               `sql:"json"`
+		Settings map[string]interface{} `sql:"json"`
+		Scores   []int32                `sql:"array"`
+		Matrix   [][]float64            `sql:"array"`
+		Names    []*string              `sql:"array"`
+		UUIDs    []uuid.UUID            `sql:"array"`
+		Flags    *[]bool                `sql:"array"`
+		Numbers  []int                  `sql:"array"`
+
+		Bounded       []int64   `sql:"array"`
+		BoundedMatrix [][]int64 `sql:"array"`
+	}
+
+	var dest []Customer
+
+	_, err := Query(context.Background(), db, `
+SELECT 1 AS "customer.id",
+       '{"city": "Paris", "zip": 75001}' AS "customer.address",
+       NULL AS "customer.previous",
+       '["a", "b"]' AS "customer.tags",
+       CAST('{"dark": true, "size": 2}' AS BLOB) AS "customer.settings",
+       '{1,2,3}' AS "customer.scores",
+       '{{1.5,2},{3,4}}' AS "customer.matrix",
+       '{abc,NULL,"x y"}' AS "customer.names",
+       '{a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11}' AS "customer.uuids",
+       '{t,f}' AS "customer.flags",
+       '[4, 5]' AS "customer.numbers",
+       '[0:2]={7,8,9}' AS "customer.bounded",
+       '[1:1][1:2]={{1,2}}' AS "customer.bounded_matrix"
+`, nil, &dest)
+
+	require.NoError(t, err)
+	require.Len(t, dest, 1)
+	require.Equal(t, Address{City: "Paris", ZipCode: 75001}, dest[0].Address)
+	require.Nil(t, dest[0].Previous)
+	require.Equal(t, []string{"a", "b"}, dest[0].Tags)
+	require.Equal(t, map[string]interface{}{"dark": true, "size": float64(2)}, dest[0].Settings)
+	require.Equal(t, []int32{1, 2, 3}, dest[0].Scores)
+	require.Equal(t, [][]float64{{1.5, 2}, {3, 4}}, dest[0].Matrix)
+	require.Equal(t, "abc", *dest[0].Names[0])
+	require.Nil(t, dest[0].Names[1])
+	require.Equal(t, "x y", *dest[0].Names[2])
+	require.Equal(t, []uuid.UUID{uuid.MustParse("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")}, dest[0].UUIDs)
+	require.Equal(t, []bool{true, false}, *dest[0].Flags)
+	require.Equal(t, []int{4, 5}, dest[0].Numbers)
+	require.Equal(t, []int64{7, 8, 9}, dest[0].Bounded)
+	require.Equal(t, [][]int64{{1, 2}}, dest[0].BoundedMatrix)
+}
+
+func TestGetFieldDecode(t *testing.T) {
+	type Model struct {
+		ID       int64    `sql:"primary_key"`
+		Tags     []string `sql:"primary_key,json"`
+		Scores   []int32  `sql:"primary_key, array"`
+		Unknown  []int32  `sql:"arrays"`
+		Untagged []int32
+	}
+
+	modelType := reflect.TypeOf(Model{})
+
+	require.Equal(t, noDecode, getFieldDecode(modelType.Field(0)))
+	require.Equal(t, jsonDecode, getFieldDecode(modelType.Field(1)))
+	require.Equal(t, arrayDecode, getFieldDecode(modelType.Field(2)))
+	require.Equal(t, noDecode, getFieldDecode(modelType.Field(3)))
+	require.Equal(t, noDecode, getFieldDecode(modelType.Field(4)))
+
+	require.True(t, isPrimaryKey(modelType.Field(1), nil))
+	require.True(t, isPrimaryKey(modelType.Field(2), nil))
+}
+
+func TestDecodeErrors(t *testing.T) {
+	db := openMemoryDB(t)
+
+	t.Run("invalid json", func(t *testing.T) {
+		var dest struct {
+			Tags []string `sql:"json" alias:"tags"`
+		}
+
+		_, err := Query(context.Background(), db, `SELECT '["a", 1]' AS "tags"`, nil, &dest)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `jet: can't decode json string("[\"a\", 1]") to 'Tags []string': json: cannot unmarshal number`)
+	})
+
+	t.Run("invalid array element", func(t *testing.T) {
+		var dest struct {
+			Scores []int32 `sql:"array" alias:"scores"`
+		}
+
+		_, err := Query(context.Background(), db, `SELECT '{1,a}' AS "scores"`, nil, &dest)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `jet: can't decode array string("{1,a}") to 'Scores []int32': array element 1:`)
+	})
+
+	t.Run("not a slice", func(t *testing.T) {
+		var dest struct {
+			Score int32 `sql:"array" alias:"score"`
+		}
+
+		_, err := Query(context.Background(), db, `SELECT '{1}' AS "score"`, nil, &dest)
+		require.EqualError(t, err, `jet: can't decode array string("{1}") to 'Score int32': can't assign array to int32`)
+	})
+
+	t.Run("not a text", func(t *testing.T) {
+		var dest struct {
+			Tags []string `sql:"json" alias:"tags"`
+		}
+
+		_, err := Query(context.Background(), db, `SELECT 11 AS "tags"`, nil, &dest)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `to 'Tags []string': expected []byte or string, got int64`)
+	})
+}
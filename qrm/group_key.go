@@ -0,0 +1,138 @@
+package qrm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/maphash"
+	"math"
+	"time"
+)
+
+// groupKeyHash is 128-bit hash identifying destination object. Group key of the object is constructed from the group
+// key of the parent object and row values of the object primary key columns.
+type groupKeyHash struct {
+	hi, lo uint64
+}
+
+const (
+	groupKeyTagStruct byte = iota + 1
+	groupKeyTagField
+	groupKeyTagMapEntry
+	groupKeyTagRowNum
+	groupKeyTagSubTypeStart
+	groupKeyTagSubTypeEnd
+)
+
+const (
+	groupKeyValueNil byte = iota + 1
+	groupKeyValueInt64
+	groupKeyValueFloat64
+	groupKeyValueBool
+	groupKeyValueBytes
+	groupKeyValueString
+	groupKeyValueTime
+	groupKeyValueOther
+)
+
+var groupKeySeeds = [2]maphash.Seed{maphash.MakeSeed(), maphash.MakeSeed()}
+
+// groupKeyHasher constructs group keys without intermediate string allocations. Two independently seeded
+// 64-bit hashes are combined into a single 128-bit group key, so collisions are practically impossible.
+type groupKeyHasher struct {
+	hashes [2]maphash.Hash
+	buf    [8]byte
+}
+
+func newGroupKeyHasher() groupKeyHasher {
+	var ret groupKeyHasher
+
+	for i := range ret.hashes {
+		ret.hashes[i].SetSeed(groupKeySeeds[i])
+	}
+
+	return ret
+}
+
+func (g *groupKeyHasher) start(parent groupKeyHash, tag byte) {
+	for i := range g.hashes {
+		g.hashes[i].Reset()
+	}
+
+	g.writeUint64(parent.hi)
+	g.writeUint64(parent.lo)
+	g.writeByte(tag)
+}
+
+func (g *groupKeyHasher) sum() groupKeyHash {
+	return groupKeyHash{
+		hi: g.hashes[0].Sum64(),
+		lo: g.hashes[1].Sum64(),
+	}
+}
+
+func (g *groupKeyHasher) writeByte(b byte) {
+	for i := range g.hashes {
+		_ = g.hashes[i].WriteByte(b)
+	}
+}
+
+func (g *groupKeyHasher) writeUint64(value uint64) {
+	binary.LittleEndian.PutUint64(g.buf[:], value)
+
+	for i := range g.hashes {
+		_, _ = g.hashes[i].Write(g.buf[:])
+	}
+}
+
+func (g *groupKeyHasher) writeString(value string) {
+	g.writeUint64(uint64(len(value)))
+
+	for i := range g.hashes {
+		_, _ = g.hashes[i].WriteString(value)
+	}
+}
+
+func (g *groupKeyHasher) writeBytes(value []byte) {
+	g.writeUint64(uint64(len(value)))
+
+	for i := range g.hashes {
+		_, _ = g.hashes[i].Write(value)
+	}
+}
+
+// writeValue writes type tag and binary representation of the scanned row value
+func (g *groupKeyHasher) writeValue(value interface{}) {
+	switch v := value.(type) {
+	case nil:
+		g.writeByte(groupKeyValueNil)
+	case int64:
+		g.writeByte(groupKeyValueInt64)
+		g.writeUint64(uint64(v))
+	case float64:
+		g.writeByte(groupKeyValueFloat64)
+		g.writeUint64(math.Float64bits(v))
+	case bool:
+		g.writeByte(groupKeyValueBool)
+		if v {
+			g.writeByte(1)
+		} else {
+			g.writeByte(0)
+		}
+	case []byte:
+		g.writeByte(groupKeyValueBytes)
+		g.writeBytes(v)
+	case string:
+		g.writeByte(groupKeyValueString)
+		g.writeString(v)
+	case time.Time:
+		g.writeByte(groupKeyValueTime)
+		g.writeUint64(uint64(v.Unix()))
+		g.writeUint64(uint64(v.Nanosecond()))
+	case fmt.Stringer:
+		g.writeByte(groupKeyValueOther)
+		g.writeString(v.String())
+	default:
+		g.writeByte(groupKeyValueOther)
+		g.writeString(fmt.Sprintf("%#v", v))
+	}
+}
@@ -0,0 +1,43 @@
+package qrm
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func valueGroupKey(parent groupKeyHash, values ...interface{}) groupKeyHash {
+	hasher := newGroupKeyHasher()
+	hasher.start(parent, groupKeyTagStruct)
+
+	for _, value := range values {
+		hasher.writeValue(value)
+	}
+
+	return hasher.sum()
+}
+
+func TestGroupKeyHasher(t *testing.T) {
+	now := time.Now()
+	id := uuid.New()
+
+	values := []interface{}{
+		nil, int64(0), int64(1), float64(1), true, false, "", "1", []byte("1"), now, now.Add(time.Nanosecond), id,
+	}
+
+	keys := map[groupKeyHash]interface{}{}
+
+	for _, value := range values {
+		key := valueGroupKey(groupKeyHash{}, value)
+
+		require.Equal(t, key, valueGroupKey(groupKeyHash{}, value))
+		require.NotContains(t, keys, key, "%v group key collides with %v", value, keys[key])
+
+		keys[key] = value
+	}
+
+	require.NotEqual(t, valueGroupKey(groupKeyHash{}, "ab", "c"), valueGroupKey(groupKeyHash{}, "a", "bc"))
+	require.NotEqual(t, valueGroupKey(groupKeyHash{}, int64(1)), valueGroupKey(groupKeyHash{hi: 1}, int64(1)))
+}
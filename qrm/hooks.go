@@ -0,0 +1,175 @@
+package qrm
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// AfterScanHook can be implemented by destination struct types to post-process struct values (for instance to decrypt
+// fields or to compute derived values). Hook is called for each destination struct, including the nested ones, after
+// the query result set is mapped into destination, so struct slices and maps are complete when hook is called.
+// Hooks of the nested structs are called before the hook of the parent struct.
+type AfterScanHook interface {
+	AfterScan() error
+}
+
+var (
+	afterScanHookType  = reflect.TypeOf((*AfterScanHook)(nil)).Elem()
+	afterScanHookCache sync.Map // reflect.Type -> bool
+)
+
+// hasAfterScanHook returns true if valueType, or any of the types destination of valueType can contain,
+// implements AfterScanHook.
+func hasAfterScanHook(valueType reflect.Type) bool {
+	if cached, ok := afterScanHookCache.Load(valueType); ok {
+		return cached.(bool)
+	}
+
+	ret := findAfterScanHook(valueType, map[reflect.Type]bool{})
+	afterScanHookCache.Store(valueType, ret)
+
+	return ret
+}
+
+func findAfterScanHook(valueType reflect.Type, visited map[reflect.Type]bool) bool {
+	if visited[valueType] {
+		return false
+	}
+
+	visited[valueType] = true
+
+	switch valueType.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		return findAfterScanHook(valueType.Elem(), visited)
+	case reflect.Struct:
+		if reflect.PtrTo(valueType).Implements(afterScanHookType) {
+			return true
+		}
+
+		for i := 0; i < valueType.NumField(); i++ {
+			field := valueType.Field(i)
+
+			if field.PkgPath != "" && !field.Anonymous { // private field
+				continue
+			}
+
+			if findAfterScanHook(field.Type, visited) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// callAfterScanHooks calls AfterScan hook for each struct reachable from destination value
+func callAfterScanHooks(value reflect.Value) error {
+	return callAfterScanHooksOn(value, true)
+}
+
+// callAfterScanHooksOnScanned calls AfterScan hooks only for the destination structs scan created or changed, so that
+// hooks are not called again for the destination slice elements or map entries added by the previous queries.
+// destSliceLen is the length of the destination slice before the scan.
+func callAfterScanHooksOnScanned(scanContext *ScanContext, destPtrValue reflect.Value, destSliceLen int) error {
+	destValue := destPtrValue.Elem()
+
+	if !hasAfterScanHook(destValue.Type()) {
+		return nil
+	}
+
+	switch destValue.Kind() {
+	case reflect.Slice:
+		for i := destSliceLen; i < destValue.Len(); i++ {
+			if err := callAfterScanHooksOn(destValue.Index(i), true); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		for _, key := range scanContext.destMapKeys {
+			if err := callAfterScanHooksOnMapEntry(destValue, key); err != nil {
+				return err
+			}
+		}
+	default:
+		return callAfterScanHooksOn(destPtrValue, true)
+	}
+
+	return nil
+}
+
+func callAfterScanHooksOn(value reflect.Value, callHook bool) error {
+	if !hasAfterScanHook(value.Type()) {
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return nil
+		}
+
+		return callAfterScanHooksOn(value.Elem(), callHook)
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			if err := callAfterScanHooksOn(value.Index(i), true); err != nil {
+				return err
+			}
+		}
+
+	case reflect.Map:
+		for _, key := range value.MapKeys() {
+			if err := callAfterScanHooksOnMapEntry(value, key); err != nil {
+				return err
+			}
+		}
+
+	case reflect.Struct:
+		structType := value.Type()
+		implementsHook := reflect.PtrTo(structType).Implements(afterScanHookType)
+
+		for i := 0; i < value.NumField(); i++ {
+			fieldValue := value.Field(i)
+
+			if !fieldValue.CanSet() { // private field
+				continue
+			}
+
+			// hook of the embedded struct is promoted to (or overridden by) parent struct hook
+			callFieldHook := !(structType.Field(i).Anonymous && implementsHook)
+
+			if err := callAfterScanHooksOn(fieldValue, callFieldHook); err != nil {
+				return err
+			}
+		}
+
+		if implementsHook && callHook && value.CanAddr() {
+			if err := value.Addr().Interface().(AfterScanHook).AfterScan(); err != nil {
+				return fmt.Errorf("AfterScan hook of %s failed: %w", structType.String(), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func callAfterScanHooksOnMapEntry(mapValue reflect.Value, key reflect.Value) error {
+	elem := mapValue.MapIndex(key)
+
+	if elem.Kind() == reflect.Ptr {
+		return callAfterScanHooksOn(elem, true)
+	}
+
+	// map values are not addressable, hooks are called on a copy stored back into the map
+	elemCopy := reflect.New(elem.Type()).Elem()
+	elemCopy.Set(elem)
+
+	if err := callAfterScanHooksOn(elemCopy, true); err != nil {
+		return err
+	}
+
+	mapValue.SetMapIndex(key, elemCopy)
+
+	return nil
+}
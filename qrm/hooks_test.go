@@ -0,0 +1,116 @@
+package qrm
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type HookedItem struct {
+	ID    int32 `sql:"primary_key"`
+	Label string
+}
+
+func (h *HookedItem) AfterScan() error {
+	if h.Label == "invalid" {
+		return errors.New("invalid label")
+	}
+
+	h.Label = strings.ToUpper(h.Label)
+	return nil
+}
+
+type HookedBox struct {
+	ID int32 `sql:"primary_key"`
+}
+
+func TestAfterScanHook(t *testing.T) {
+	db := openMemoryDB(t)
+
+	query := `
+SELECT 1 AS "hooked_box.id", 1 AS "hooked_item.id", 'a' AS "hooked_item.label"
+UNION ALL
+SELECT 1, 2, 'b'
+UNION ALL
+SELECT 2, 3, 'c'`
+
+	type Box struct {
+		HookedBox
+		Items []HookedItem
+	}
+
+	var dest []Box
+
+	_, err := Query(context.Background(), db, query, nil, &dest)
+	require.NoError(t, err)
+	require.Len(t, dest, 2)
+	require.Equal(t, []HookedItem{{ID: 1, Label: "A"}, {ID: 2, Label: "B"}}, dest[0].Items)
+	require.Equal(t, "C", dest[1].Items[0].Label)
+
+	t.Run("map and struct destination", func(t *testing.T) {
+		var mapDest map[int32]HookedItem
+
+		_, err := Query(context.Background(), db, query, nil, &mapDest)
+		require.NoError(t, err)
+		require.Equal(t, "B", mapDest[2].Label)
+
+		var structDest HookedItem
+
+		_, err = Query(context.Background(), db, query, nil, &structDest)
+		require.NoError(t, err)
+		require.Equal(t, HookedItem{ID: 1, Label: "A"}, structDest)
+	})
+
+	t.Run("error", func(t *testing.T) {
+		var dest []HookedItem
+
+		_, err := Query(context.Background(), db, `SELECT 1 AS "hooked_item.id", 'invalid' AS "hooked_item.label"`, nil, &dest)
+		require.EqualError(t, err, "jet: AfterScan hook of qrm.HookedItem failed: invalid label")
+	})
+}
+
+type CountedItem struct {
+	ID         int32 `sql:"primary_key"`
+	HookCalled int
+}
+
+func (c *CountedItem) AfterScan() error {
+	c.HookCalled++
+	return nil
+}
+
+func TestAfterScanHookCalledOnce(t *testing.T) {
+	db := openMemoryDB(t)
+
+	t.Run("slice", func(t *testing.T) {
+		var dest []CountedItem
+
+		_, err := Query(context.Background(), db, `SELECT 1 AS "counted_item.id" UNION ALL SELECT 2`, nil, &dest)
+		require.NoError(t, err)
+		_, err = Query(context.Background(), db, `SELECT 3 AS "counted_item.id"`, nil, &dest)
+		require.NoError(t, err)
+
+		require.Equal(t, []CountedItem{{ID: 1, HookCalled: 1}, {ID: 2, HookCalled: 1}, {ID: 3, HookCalled: 1}}, dest)
+	})
+
+	t.Run("map", func(t *testing.T) {
+		var dest map[int32]CountedItem
+
+		_, err := Query(context.Background(), db, `SELECT 1 AS "counted_item.id" UNION ALL SELECT 1`, nil, &dest)
+		require.NoError(t, err)
+		_, err = Query(context.Background(), db, `SELECT 2 AS "counted_item.id"`, nil, &dest)
+		require.NoError(t, err)
+
+		require.Equal(t, map[int32]CountedItem{1: {ID: 1, HookCalled: 1}, 2: {ID: 2, HookCalled: 1}}, dest)
+	})
+}
+
+func TestHasAfterScanHook(t *testing.T) {
+	require.True(t, hasAfterScanHook(reflect.TypeOf(HookedItem{})))
+	require.True(t, hasAfterScanHook(reflect.TypeOf(&[]struct{ Items map[int]*HookedItem }{})))
+	require.False(t, hasAfterScanHook(reflect.TypeOf([]HookedBox{})))
+}
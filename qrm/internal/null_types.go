@@ -76,6 +76,12 @@ var formats = []string{
 	"15:04:05.999999",            // pgx
 }
 
+// time formats of JSON encoded timestamps, for instance from JSON aggregated rows
+var isoFormats = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+}
+
 func tryParseAsTime(value interface{}) (time.Time, bool) {
 
 	var timeStr string
@@ -91,6 +97,12 @@ func tryParseAsTime(value interface{}) (time.Time, bool) {
 		return time.Time{}, false
 	}
 
+	for _, format := range isoFormats {
+		if t, err := time.Parse(format, timeStr); err == nil {
+			return t, true
+		}
+	}
+
 	for _, format := range formats {
 		formatLen := min.Int(len(format), len(timeStr))
 		t, err := time.Parse(format[:formatLen], timeStr)
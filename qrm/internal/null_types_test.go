@@ -48,6 +48,14 @@ func TestNullTime(t *testing.T) {
 	value, _ = nullTime.Value()
 	require.Equal(t, fmt.Sprintf("%v", value), "0000-01-01 13:10:11 +0000 UTC")
 
+	require.NoError(t, nullTime.Scan("2020-02-03T13:10:11.123+01:00"))
+	value, _ = nullTime.Value()
+	require.Equal(t, fmt.Sprintf("%v", value), "2020-02-03 13:10:11.123 +0100 +0100")
+
+	require.NoError(t, nullTime.Scan("2020-02-03T13:10:11"))
+	value, _ = nullTime.Value()
+	require.Equal(t, fmt.Sprintf("%v", value), "2020-02-03 13:10:11 +0000 UTC")
+
 	require.Error(t, nullTime.Scan(12), "can't scan time.Time from 12")
 }
 
@@ -0,0 +1,302 @@
+package qrm
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-jet/jet/v2/internal/utils"
+)
+
+// JSONRowsKey is the key of the JSON object that wraps the JSON array of rows aggregated with JSON aggregation
+// statement (SELECT_JSON_ARR). Only column values wrapped this way are decoded into the nested destination fields,
+// other JSON documents are mapped as regular column values.
+const JSONRowsKey = "__jet_rows"
+
+// isJSONRowsType returns true if field type is struct, or slice or map of structs, with optional pointer indirections.
+// Fields of those types can be mapped from the JSON array of objects created with JSON aggregation statement
+// (SELECT_JSON_ARR), where object keys are column aliases.
+func isJSONRowsType(fieldType reflect.Type) bool {
+	fieldType = indirectType(fieldType)
+
+	if fieldType.Kind() == reflect.Slice || fieldType.Kind() == reflect.Map {
+		fieldType = indirectType(fieldType.Elem())
+	}
+
+	return fieldType.Kind() == reflect.Struct
+}
+
+// jsonRowsElem returns JSON array of rows from the field column, if the column contains JSON rows wrapped
+// under JSONRowsKey. JSON null rows are reported as empty JSON document.
+func (s *ScanContext) jsonRowsElem(fieldMap *fieldMapping) (jsonRows []byte, ok bool) {
+	if !fieldMap.jsonRows {
+		return nil, false
+	}
+
+	switch value := s.rowElem(fieldMap.rowIndex).(type) {
+	case []byte:
+		jsonRows, ok = unwrapJSONRows(value)
+	case string:
+		jsonRows, ok = unwrapJSONRows([]byte(value))
+	}
+
+	if !ok {
+		return nil, false
+	}
+
+	s.columnMapped(fieldMap.rowIndex)
+
+	return jsonRows, true
+}
+
+// unwrapJSONRows returns JSON array of rows wrapped in the JSON object under JSONRowsKey.
+// ok is false if value is not such JSON object.
+func unwrapJSONRows(value []byte) (jsonRows []byte, ok bool) {
+	value = bytes.TrimSpace(value)
+
+	if len(value) == 0 || value[0] != '{' || !bytes.Contains(value, []byte(JSONRowsKey)) {
+		return nil, false
+	}
+
+	var wrapper map[string]json.RawMessage
+
+	if err := json.Unmarshal(value, &wrapper); err != nil || len(wrapper) != 1 {
+		return nil, false
+	}
+
+	jsonRows, ok = wrapper[JSONRowsKey]
+
+	if !ok {
+		return nil, false
+	}
+
+	if len(jsonRows) > 0 && jsonRows[0] == '"' { // database returned JSON array as a JSON string
+		var str string
+
+		if err := json.Unmarshal(jsonRows, &str); err != nil {
+			return nil, false
+		}
+
+		jsonRows = []byte(str)
+	}
+
+	jsonRows = bytes.TrimSpace(jsonRows)
+
+	if bytes.Equal(jsonRows, []byte("null")) {
+		return nil, true
+	}
+
+	return jsonRows, true
+}
+
+// mapJSONRowsToField maps each JSON object from jsonRows as a separate row into destination field. JSON objects are
+// mapped with a new scan context, so objects are grouped into destination using the same rules as result set rows.
+func mapJSONRowsToField(
+	scanContext *ScanContext,
+	jsonRows []byte,
+	fieldValue reflect.Value,
+	field *reflect.StructField,
+) (updated bool, err error) {
+
+	if len(jsonRows) == 0 {
+		return false, nil
+	}
+
+	aliases, rows, err := parseJSONRows(jsonRows)
+
+	if err != nil {
+		return false, fmt.Errorf("can't decode JSON rows to '%s %s': %w", field.Name, field.Type.String(), err)
+	}
+
+	jsonContext := newScanContext(aliases)
+
+	if scanContext.strictScan != nil {
+		jsonContext.SetStrictScan(true)
+		jsonContext.strictScan.fieldPath = append([]string{}, scanContext.strictScan.fieldPath...)
+	}
+
+	for _, row := range rows {
+		for i, value := range row {
+			*jsonContext.row[i].(*interface{}) = value
+		}
+
+		jsonContext.rowNum++
+
+		changed, err := mapRowToDestinationValue(jsonContext, groupKeyHash{}, fieldValue, field)
+
+		if err != nil {
+			return updated, err
+		}
+
+		if changed {
+			updated = true
+		}
+	}
+
+	return updated, jsonContext.strictScanError()
+}
+
+// parseJSONRows parses JSON array of objects into the list of rows. Column aliases are sorted object keys.
+// Nested JSON arrays and objects are returned as raw JSON documents ([]byte).
+func parseJSONRows(jsonRows []byte) (aliases []string, rows [][]interface{}, err error) {
+	var objects []map[string]json.RawMessage
+
+	if err = json.Unmarshal(jsonRows, &objects); err != nil {
+		return nil, nil, err
+	}
+
+	aliasIndex := map[string]int{}
+
+	for _, object := range objects {
+		for key := range object {
+			if _, ok := aliasIndex[key]; !ok {
+				aliasIndex[key] = -1
+				aliases = append(aliases, key)
+			}
+		}
+	}
+
+	sort.Strings(aliases)
+
+	for i, alias := range aliases {
+		aliasIndex[alias] = i
+	}
+
+	for _, object := range objects {
+		row := make([]interface{}, len(aliases))
+
+		for key, rawValue := range object {
+			row[aliasIndex[key]], err = parseJSONRowValue(rawValue)
+
+			if err != nil {
+				return nil, nil, fmt.Errorf("key '%s': %w", key, err)
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	return aliases, rows, nil
+}
+
+// parseJSONRowValue converts JSON value into the value database driver would return for the column:
+// nil, bool, string, int64, float64, or []byte for nested JSON arrays and objects.
+func parseJSONRowValue(rawValue json.RawMessage) (interface{}, error) {
+	if len(rawValue) == 0 {
+		return nil, nil
+	}
+
+	switch rawValue[0] {
+	case 'n':
+		return nil, nil
+	case 't', 'f':
+		return rawValue[0] == 't', nil
+	case '"':
+		var str string
+		err := json.Unmarshal(rawValue, &str)
+		return str, err
+	case '[', '{':
+		return []byte(rawValue), nil
+	}
+
+	number := string(rawValue)
+
+	if !strings.ContainsAny(number, ".eE") {
+		if integer, err := strconv.ParseInt(number, 10, 64); err == nil {
+			return integer, nil
+		}
+	}
+
+	return strconv.ParseFloat(number, 64)
+}
+
+// DestinationNesting describes destination types mapped from the same result set row, and destination fields,
+// of slice or map of structs type, nested below them. Nested fields can be mapped from the JSON rows aggregated
+// into a single column of the parent row.
+type DestinationNesting struct {
+	// Alias of the column nested field is mapped from, 'type_name.field_name' or 'field_name' for the fields of
+	// anonymous structs. Empty for the destination itself.
+	Alias string
+	// TypeNames are the type names (column alias prefixes) of the destination fields mapped at this nesting level
+	TypeNames []string
+	Nested    []DestinationNesting
+}
+
+// GetDestinationNesting returns nesting of the destination type. Destination has to be a pointer to struct, slice
+// or map of structs, otherwise returned nesting is empty.
+func GetDestinationNesting(destination interface{}) DestinationNesting {
+	var ret DestinationNesting
+
+	destinationType := reflect.TypeOf(destination)
+
+	if destinationType == nil {
+		return ret
+	}
+
+	destinationType = indirectType(destinationType)
+
+	if destinationType.Kind() == reflect.Slice || destinationType.Kind() == reflect.Map {
+		destinationType = indirectType(destinationType.Elem())
+	}
+
+	if destinationType.Kind() == reflect.Struct {
+		typesVisited := newTypeStack()
+		ret.addStruct(destinationType, nil, &typesVisited)
+	}
+
+	return ret
+}
+
+func (d *DestinationNesting) addStruct(structType reflect.Type, parentField *reflect.StructField, typesVisited *typeStack) {
+	if typesVisited.contains(structType) {
+		return
+	}
+
+	typesVisited.push(structType)
+	defer typesVisited.pop()
+
+	typeName := getTypeName(structType, parentField)
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+
+		if field.PkgPath != "" { // private field
+			continue
+		}
+
+		fieldTypeName, fieldName := getTypeAndFieldName(typeName, field)
+		fieldType := indirectType(field.Type)
+
+		switch {
+		case getFieldDecode(field) != noDecode || implementsScannerType(field.Type) || isSimpleModelType(field.Type):
+			d.addTypeName(fieldTypeName)
+		case fieldType.Kind() == reflect.Struct:
+			d.addStruct(fieldType, &field, typesVisited)
+		case isJSONRowsType(fieldType):
+			nested := DestinationNesting{Alias: strings.ToLower(fieldName)}
+
+			if fieldTypeName != "" {
+				nested.Alias = strings.ToLower(fieldTypeName + "." + fieldName)
+			}
+
+			nested.addStruct(indirectType(fieldType.Elem()), &field, typesVisited)
+			d.Nested = append(d.Nested, nested)
+		default: // slices of simple types
+			d.addTypeName(fieldTypeName)
+		}
+	}
+}
+
+func (d *DestinationNesting) addTypeName(typeName string) {
+	typeName = toCommonIdentifier(typeName)
+
+	if typeName == "" || utils.StringSliceContains(d.TypeNames, typeName) {
+		return
+	}
+
+	d.TypeNames = append(d.TypeNames, typeName)
+}
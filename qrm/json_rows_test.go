@@ -0,0 +1,163 @@
+package qrm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJSONRows(t *testing.T) {
+	aliases, rows, err := parseJSONRows([]byte(`[{"b.id": 1, "b.name": "x", "b.score": 1.5},
+		{"b.id": 2, "b.name": null, "b.flag": true, "b.items": [{"c.id": 3}]}]`))
+
+	require.NoError(t, err)
+	require.Equal(t, []string{"b.flag", "b.id", "b.items", "b.name", "b.score"}, aliases)
+	require.Equal(t, [][]interface{}{
+		{nil, int64(1), nil, "x", 1.5},
+		{true, int64(2), []byte(`[{"c.id": 3}]`), nil, nil},
+	}, rows)
+
+	aliases, rows, err = parseJSONRows([]byte(`[{"id": 1e3}]`))
+	require.NoError(t, err)
+	require.Equal(t, []string{"id"}, aliases)
+	require.Equal(t, [][]interface{}{{float64(1000)}}, rows)
+
+	_, _, err = parseJSONRows([]byte(`[1, 2]`))
+	require.Error(t, err)
+}
+
+func TestUnwrapJSONRows(t *testing.T) {
+	jsonRows, ok := unwrapJSONRows([]byte(` {"__jet_rows" : [{"id": 1}]} `))
+	require.True(t, ok)
+	require.Equal(t, `[{"id": 1}]`, string(jsonRows))
+
+	jsonRows, ok = unwrapJSONRows([]byte(`{"__jet_rows": "[{\"id\": 1}]"}`))
+	require.True(t, ok)
+	require.Equal(t, `[{"id": 1}]`, string(jsonRows))
+
+	jsonRows, ok = unwrapJSONRows([]byte(`{"__jet_rows": null}`))
+	require.True(t, ok)
+	require.Nil(t, jsonRows)
+
+	for _, value := range []string{``, `[{"id": 1}]`, `{"id": 1}`, `{"__jet_rows": [], "id": 1}`, `{"__jet_rows": [}`} {
+		_, ok = unwrapJSONRows([]byte(value))
+		require.False(t, ok, value)
+	}
+}
+
+func TestMapJSONRows(t *testing.T) {
+	db := openMemoryDB(t)
+
+	type Item struct {
+		ID   int32 `sql:"primary_key"`
+		Name *string
+	}
+
+	type Box struct {
+		ID int32 `sql:"primary_key"`
+	}
+
+	query := `
+SELECT 1 AS "box.id", '{"__jet_rows": [{"item.id": 2, "item.name": "b"}, {"item.id": 1, "item.name": null}]}' AS "items"
+UNION ALL
+SELECT 2, '{"__jet_rows": []}'
+UNION ALL
+SELECT 3, NULL`
+
+	var dest []struct {
+		Box
+		Items []Item
+	}
+
+	_, err := Query(context.Background(), db, query, nil, &dest)
+	require.NoError(t, err)
+	require.Len(t, dest, 3)
+	require.Len(t, dest[0].Items, 2)
+	require.Equal(t, int32(2), dest[0].Items[0].ID)
+	require.Equal(t, "b", *dest[0].Items[0].Name)
+	require.Nil(t, dest[0].Items[1].Name)
+	require.Empty(t, dest[1].Items)
+	require.Empty(t, dest[2].Items)
+
+	t.Run("strict scan", func(t *testing.T) {
+		var dest []struct {
+			Box
+			Items []Item
+		}
+
+		_, err := Query(WithStrictScan(context.Background(), true), db,
+			`SELECT 1 AS "box.id", '{"__jet_rows": [{"item.id": 2, "item.label": "b"}]}' AS "items"`, nil, &dest)
+		require.EqualError(t, err, "jet: strict scan: columns not mapped to any destination field: item.label; "+
+			"destination fields without matching column: Items.Name")
+	})
+
+	t.Run("invalid json", func(t *testing.T) {
+		var dest []struct {
+			Box
+			Items []Item
+		}
+
+		_, err := Query(context.Background(), db, `SELECT 1 AS "box.id", '{"__jet_rows": [1, 2]}' AS "items"`, nil, &dest)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "jet: can't decode JSON rows to 'Items []qrm.Item'")
+	})
+
+	t.Run("unwrapped json", func(t *testing.T) {
+		var dest []struct {
+			Box
+			Items []Item
+		}
+
+		_, err := Query(context.Background(), db, `SELECT 1 AS "box.id", '[{"item.id": 2}]' AS "items"`, nil, &dest)
+		require.NoError(t, err)
+		require.Len(t, dest, 1)
+		require.Empty(t, dest[0].Items)
+	})
+}
+
+func TestGetDestinationNesting(t *testing.T) {
+	type Language struct {
+		LanguageID int32 `sql:"primary_key"`
+	}
+
+	type Actor struct {
+		ActorID   int32 `sql:"primary_key"`
+		FirstName string
+	}
+
+	type Film struct {
+		FilmID   int32 `sql:"primary_key"`
+		Language Language
+		Actors   []Actor
+	}
+
+	var dest []struct {
+		Film
+		Categories map[int64]*struct {
+			Name  string `alias:"category.name"`
+			Films []Film `alias:"similar_film"`
+		}
+		Rating []string `alias:"film.rating"`
+	}
+
+	require.Equal(t, DestinationNesting{
+		TypeNames: []string{"film", "language"},
+		Nested: []DestinationNesting{
+			{Alias: "film.actors", TypeNames: []string{"actor"}},
+			{
+				Alias:     "categories",
+				TypeNames: []string{"category"},
+				Nested: []DestinationNesting{
+					{
+						Alias:     "similarfilm",
+						TypeNames: []string{"similarfilm", "language"},
+						Nested:    []DestinationNesting{{Alias: "similarfilm.actors", TypeNames: []string{"actor"}}},
+					},
+				},
+			},
+		},
+	}, GetDestinationNesting(&dest))
+
+	require.Equal(t, DestinationNesting{}, GetDestinationNesting(new(int64)))
+}
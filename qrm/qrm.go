@@ -2,7 +2,6 @@ package qrm
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"fmt"
 	"reflect"
@@ -15,55 +14,155 @@ var ErrNoRows = errors.New("qrm: no rows in result set")
 
 // Query executes Query Result Mapping (QRM) of `query` with list of parametrized arguments `arg` over database connection `db`
 // using context `ctx` into destination `destPtr`.
-// Destination can be either pointer to struct or pointer to slice of structs.
-// If destination is pointer to struct and query result set is empty, method returns qrm.ErrNoRows.
+// Destination can be either pointer to struct, pointer to slice of structs, pointer to map of structs keyed by struct
+// primary key, pointer to map of column names and values (map[string]interface{}) or pointer to slice of such maps.
+// If destination is pointer to struct (or to map of column values) and query result set is empty, method returns qrm.ErrNoRows.
 func Query(ctx context.Context, db Queryable, query string, args []interface{}, destPtr interface{}) (rowsProcessed int64, err error) {
 
 	utils.MustBeInitializedPtr(db, "jet: db is nil")
-	utils.MustBeInitializedPtr(destPtr, "jet: destination is nil")
-	utils.MustBe(destPtr, reflect.Ptr, "jet: destination has to be a pointer to slice or pointer to struct")
+	mustBeDestination(destPtr)
 
-	destinationPtrType := reflect.TypeOf(destPtr)
+	if ctx == nil {
+		ctx = context.Background()
+	}
 
-	if destinationPtrType.Elem().Kind() == reflect.Slice {
-		rowsProcessed, err := queryToSlice(ctx, db, query, args, destPtr)
-		if err != nil {
-			return rowsProcessed, fmt.Errorf("jet: %w", err)
+	rows, err := db.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return 0, fmt.Errorf("jet: %w", err)
+	}
+
+	return ScanRows(ctx, rows, destPtr)
+}
+
+// ScanRows executes Query Result Mapping (QRM) of all the rows from the row source into destination `destPtr`,
+// and closes the rows afterwards. Destination has the same requirements as the Query destination.
+func ScanRows(ctx context.Context, rows RowSource, destPtr interface{}) (rowsProcessed int64, err error) {
+	defer rows.Close()
+
+	mustBeDestination(destPtr)
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	rowsProcessed, err = mapResultSetToDestination(ctx, rows, destPtr)
+
+	if err == ErrNoRows {
+		return 0, err
+	}
+
+	if err != nil {
+		return rowsProcessed, fmt.Errorf("jet: %w", err)
+	}
+
+	if err = rows.Close(); err != nil {
+		return rowsProcessed, fmt.Errorf("jet: %w", err)
+	}
+
+	return rowsProcessed, nil
+}
+
+// QueryMultiple executes Query Result Mapping (QRM) of `query` returning multiple result sets (for instance multi-statement
+// query, or stored procedure call), and maps each of the result sets into destination at the same index.
+// Each destination has the same requirements as the Query destination. If query returns fewer result sets than the
+// number of destinations, error is returned. Returned rowsProcessed is the total number of rows in all result sets.
+func QueryMultiple(ctx context.Context, db Queryable, query string, args []interface{}, destinations ...interface{}) (rowsProcessed int64, err error) {
+
+	utils.MustBeInitializedPtr(db, "jet: db is nil")
+
+	for _, destPtr := range destinations {
+		mustBeDestination(destPtr)
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return 0, fmt.Errorf("jet: %w", err)
+	}
+	defer rows.Close()
+
+	for i, destPtr := range destinations {
+		if i > 0 && !rows.NextResultSet() {
+			if err = rows.Err(); err != nil {
+				return rowsProcessed, fmt.Errorf("jet: result set %d: %w", i, err)
+			}
+
+			return rowsProcessed, fmt.Errorf("jet: query returned %d result sets, expected %d", i, len(destinations))
 		}
-		return rowsProcessed, nil
-	} else if destinationPtrType.Elem().Kind() == reflect.Struct {
-		tempSlicePtrValue := reflect.New(reflect.SliceOf(destinationPtrType))
-		tempSliceValue := tempSlicePtrValue.Elem()
 
-		rowsProcessed, err := queryToSlice(ctx, db, query, args, tempSlicePtrValue.Interface())
+		resultSetRows, err := mapResultSetToDestination(ctx, rows, destPtr)
+		rowsProcessed += resultSetRows
 
 		if err != nil {
-			return rowsProcessed, fmt.Errorf("jet: %w", err)
+			return rowsProcessed, fmt.Errorf("jet: result set %d: %w", i, err)
 		}
+	}
 
-		if rowsProcessed == 0 {
-			return 0, ErrNoRows
-		}
+	if err = rows.Close(); err != nil {
+		return rowsProcessed, fmt.Errorf("jet: %w", err)
+	}
 
-		// edge case when row result set contains only NULLs.
-		if tempSliceValue.Len() == 0 {
-			return rowsProcessed, nil
-		}
+	return rowsProcessed, nil
+}
 
-		structValue := reflect.ValueOf(destPtr).Elem()
-		firstTempStruct := tempSliceValue.Index(0).Elem()
+func mustBeDestination(destPtr interface{}) {
+	utils.MustBeInitializedPtr(destPtr, "jet: destination is nil")
+	utils.MustBe(destPtr, reflect.Ptr, "jet: destination has to be a pointer to slice or pointer to struct")
 
-		if structValue.Type().AssignableTo(firstTempStruct.Type()) {
-			structValue.Set(tempSliceValue.Index(0).Elem())
-		}
-		return rowsProcessed, nil
-	} else {
+	destinationType := reflect.TypeOf(destPtr).Elem()
+
+	switch destinationType.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Struct:
+	default:
 		panic("jet: destination has to be a pointer to slice or pointer to struct")
 	}
 }
 
+// mapResultSetToDestination maps current result set of rows into destination. If destination is pointer to struct
+// (or to map of column values) and result set is empty, ErrNoRows is returned.
+func mapResultSetToDestination(ctx context.Context, rows RowSource, destPtr interface{}) (rowsProcessed int64, err error) {
+	destinationPtrType := reflect.TypeOf(destPtr)
+	destinationType := destinationPtrType.Elem()
+
+	if destinationType.Kind() == reflect.Slice || (destinationType.Kind() == reflect.Map && !isRowMapType(destinationType)) {
+		return mapRowsToDestination(ctx, rows, destPtr)
+	}
+
+	tempSlicePtrValue := reflect.New(reflect.SliceOf(destinationPtrType))
+	tempSliceValue := tempSlicePtrValue.Elem()
+
+	rowsProcessed, err = mapRowsToDestination(ctx, rows, tempSlicePtrValue.Interface())
+
+	if err != nil {
+		return rowsProcessed, err
+	}
+
+	if rowsProcessed == 0 {
+		return 0, ErrNoRows
+	}
+
+	// edge case when row result set contains only NULLs.
+	if tempSliceValue.Len() == 0 {
+		return rowsProcessed, nil
+	}
+
+	structValue := reflect.ValueOf(destPtr).Elem()
+	firstTempStruct := tempSliceValue.Index(0).Elem()
+
+	if structValue.Type().AssignableTo(firstTempStruct.Type()) {
+		structValue.Set(tempSliceValue.Index(0).Elem())
+	}
+
+	return rowsProcessed, nil
+}
+
 // ScanOneRowToDest will scan one row into struct destination
-func ScanOneRowToDest(scanContext *ScanContext, rows *sql.Rows, destPtr interface{}) error {
+func ScanOneRowToDest(scanContext *ScanContext, rows RowSource, destPtr interface{}) error {
 	utils.MustBeInitializedPtr(destPtr, "jet: destination is nil")
 	utils.MustBe(destPtr, reflect.Ptr, "jet: destination has to be a pointer to slice or pointer to struct")
 
@@ -79,27 +178,26 @@ func ScanOneRowToDest(scanContext *ScanContext, rows *sql.Rows, destPtr interfac
 
 	destValuePtr := reflect.ValueOf(destPtr)
 
-	_, err = mapRowToStruct(scanContext, "", destValuePtr, nil)
+	scanContext.rowNum++
+
+	_, err = mapRowToStruct(scanContext, groupKeyHash{}, destValuePtr, nil)
 
 	if err != nil {
 		return fmt.Errorf("jet: failed to scan a row into destination, %w", err)
 	}
 
-	return nil
-}
-
-func queryToSlice(ctx context.Context, db Queryable, query string, args []interface{}, slicePtr interface{}) (rowsProcessed int64, err error) {
-	if ctx == nil {
-		ctx = context.Background()
+	if err = scanContext.strictScanError(); err != nil {
+		return fmt.Errorf("jet: %w", err)
 	}
 
-	rows, err := db.QueryContext(ctx, query, args...)
-
-	if err != nil {
-		return
+	if err = callAfterScanHooks(destValuePtr); err != nil {
+		return fmt.Errorf("jet: %w", err)
 	}
-	defer rows.Close()
 
+	return nil
+}
+
+func mapRowsToDestination(ctx context.Context, rows RowSource, destPtr interface{}) (rowsProcessed int64, err error) {
 	scanContext, err := NewScanContext(rows)
 
 	if err != nil {
@@ -110,7 +208,14 @@ func queryToSlice(ctx context.Context, db Queryable, query string, args []interf
 		return
 	}
 
-	slicePtrValue := reflect.ValueOf(slicePtr)
+	scanContext.SetStrictScan(IsStrictScan(ctx))
+
+	destPtrValue := reflect.ValueOf(destPtr)
+	destSliceLen := 0
+
+	if destPtrValue.Elem().Kind() == reflect.Slice {
+		destSliceLen = destPtrValue.Elem().Len()
+	}
 
 	for rows.Next() {
 		err = rows.Scan(scanContext.row...)
@@ -121,24 +226,27 @@ func queryToSlice(ctx context.Context, db Queryable, query string, args []interf
 
 		scanContext.rowNum++
 
-		_, err = mapRowToSlice(scanContext, "", slicePtrValue, nil)
+		_, err = mapRowToDestinationPtr(scanContext, groupKeyHash{}, destPtrValue, nil)
 
 		if err != nil {
 			return scanContext.rowNum, err
 		}
 	}
 
-	err = rows.Close()
-	if err != nil {
+	if err = rows.Err(); err != nil {
 		return scanContext.rowNum, err
 	}
 
-	return scanContext.rowNum, rows.Err()
+	if err = scanContext.strictScanError(); err != nil {
+		return scanContext.rowNum, err
+	}
+
+	return scanContext.rowNum, callAfterScanHooksOnScanned(scanContext, destPtrValue, destSliceLen)
 }
 
 func mapRowToSlice(
 	scanContext *ScanContext,
-	groupKey string,
+	groupKey groupKeyHash,
 	slicePtrValue reflect.Value,
 	field *reflect.StructField) (updated bool, err error) {
 
@@ -149,11 +257,16 @@ func mapRowToSlice(
 		return
 	}
 
-	utils.TypeMustBe(sliceElemType, reflect.Struct, "jet: unsupported slice element type"+fieldToString(field))
+	if isRowMapType(sliceElemType) && field == nil {
+		updated, err = mapRowToRowMapSlice(scanContext, slicePtrValue)
+		return
+	}
 
-	structGroupKey := scanContext.getGroupKey(sliceElemType, field)
+	if sliceElemType.Kind() != reflect.Struct {
+		panic("jet: unsupported slice element type" + fieldToString(field))
+	}
 
-	groupKey = concat(groupKey, ",", structGroupKey)
+	groupKey = scanContext.getGroupKey(groupKey, sliceElemType, field)
 
 	index, ok := scanContext.uniqueDestObjectsMap[groupKey]
 
@@ -188,9 +301,12 @@ func mapRowToBaseTypeSlice(scanContext *ScanContext, slicePtrValue reflect.Value
 	if field != nil {
 		typeName, columnName := getTypeAndFieldName("", *field)
 		if index = scanContext.typeToColumnIndex(typeName, columnName); index < 0 {
+			scanContext.fieldUnfilled("")
 			return
 		}
 	}
+
+	scanContext.columnMapped(index)
 	rowElemPtr := scanContext.rowElemValueClonePtr(index)
 
 	if rowElemPtr.IsValid() && !rowElemPtr.IsNil() {
@@ -204,9 +320,112 @@ func mapRowToBaseTypeSlice(scanContext *ScanContext, slicePtrValue reflect.Value
 	return
 }
 
+func mapRowToRowMapSlice(scanContext *ScanContext, slicePtrValue reflect.Value) (updated bool, err error) {
+	rowMapPtr := newElemPtrValueForSlice(slicePtrValue)
+	rowMapPtr.Elem().Set(reflect.MakeMapWithSize(rowMapPtr.Elem().Type(), len(scanContext.row)))
+
+	err = mapRowToRowMap(scanContext, rowMapPtr.Elem())
+
+	if err != nil {
+		return
+	}
+
+	return true, appendElemToSlice(slicePtrValue, rowMapPtr)
+}
+
+// mapRowToRowMap stores each column value of the current row into rowMap, using column alias as a key
+func mapRowToRowMap(scanContext *ScanContext, rowMap reflect.Value) error {
+	mapValueType := rowMap.Type().Elem()
+
+	for index, columnAlias := range scanContext.columnAliases {
+		scanContext.columnMapped(index)
+		mapValue := reflect.New(mapValueType).Elem()
+		scannedValue := scanContext.rowElemValue(index)
+
+		if scannedValue.IsValid() {
+			if scannedValue.Type() == byteArrayType {
+				scannedValue = reflect.ValueOf(cloneBytes(scannedValue.Interface().([]byte)))
+			}
+
+			err := assign(scannedValue, mapValue)
+
+			if err != nil {
+				return fmt.Errorf(`can't assign %T(%q) to '%s %s': %w`, scannedValue.Interface(), scannedValue.Interface(),
+					columnAlias, mapValueType.String(), err)
+			}
+		}
+
+		rowMap.SetMapIndex(reflect.ValueOf(columnAlias), mapValue)
+	}
+
+	return nil
+}
+
+func mapRowToMap(
+	scanContext *ScanContext,
+	groupKey groupKeyHash,
+	mapPtrValue reflect.Value,
+	field *reflect.StructField) (updated bool, err error) {
+
+	mapType := mapPtrValue.Type().Elem()
+	mapElemType := indirectType(mapType.Elem())
+
+	if mapElemType.Kind() == reflect.Slice {
+		mapElemType = indirectType(mapElemType.Elem())
+	}
+
+	if mapElemType.Kind() != reflect.Struct {
+		panic("jet: unsupported map value type" + fieldToString(field))
+	}
+
+	keyIndexes := scanContext.getMapKeyIndexes(mapElemType, field)
+
+	if len(keyIndexes) == 0 {
+		return false, fmt.Errorf("can't find map key columns for %s%s", mapElemType.String(), fieldToString(field))
+	}
+
+	for _, keyIndex := range keyIndexes {
+		scanContext.columnMapped(keyIndex)
+	}
+
+	mapKey, err := scanContext.constructMapKey(mapType.Key(), keyIndexes)
+
+	if err != nil || !mapKey.IsValid() {
+		return false, err
+	}
+
+	entryGroupKey := scanContext.getMapEntryGroupKey(groupKey, keyIndexes)
+
+	entryPtrValue, ok := scanContext.uniqueDestMapEntries[entryGroupKey]
+
+	if !ok {
+		entryPtrValue = reflect.New(mapType.Elem())
+	}
+
+	updated, err = mapRowToDestinationValue(scanContext, entryGroupKey, entryPtrValue.Elem(), field)
+
+	if err != nil || (!ok && !updated) {
+		return
+	}
+
+	if mapPtrValue.Elem().IsNil() {
+		mapPtrValue.Elem().Set(reflect.MakeMap(mapType))
+	}
+
+	if !ok && field == nil { // destination map entry
+		scanContext.destMapKeys = append(scanContext.destMapKeys, mapKey)
+	}
+
+	scanContext.uniqueDestMapEntries[entryGroupKey] = entryPtrValue
+	// map values are not addressable, so map entry is set again after each row mapping
+	mapPtrValue.Elem().SetMapIndex(mapKey, entryPtrValue.Elem())
+
+	return true, nil
+}
+
 func mapRowToStruct(
 	scanContext *ScanContext,
-	groupKey string,
+	groupKey groupKeyHash,
 	structPtrValue reflect.Value,
 	parentField *reflect.StructField,
 	onlySlices ...bool, // small optimization, not to assign to already assigned struct fields
@@ -215,11 +434,11 @@ func mapRowToStruct(
 	mapOnlySlices := len(onlySlices) > 0
 	structType := structPtrValue.Type().Elem()
 
-	if scanContext.typesVisited.contains(&structType) {
+	if scanContext.typesVisited.contains(structType) {
 		return false, nil
 	}
 
-	scanContext.typesVisited.push(&structType)
+	scanContext.typesVisited.push(structType)
 	defer scanContext.typesVisited.pop()
 
 	typeInf := scanContext.getTypeInfo(structType, parentField)
@@ -227,18 +446,27 @@ func mapRowToStruct(
 	structValue := structPtrValue.Elem()
 
 	for i := 0; i < structValue.NumField(); i++ {
-		field := structType.Field(i)
+		fieldMap := &typeInf.fieldMappings[i]
+		field := &fieldMap.field
 		fieldValue := structValue.Field(i)
 
 		if !fieldValue.CanSet() { // private field
 			continue
 		}
 
-		fieldMap := typeInf.fieldMappings[i]
-
 		if fieldMap.complexType {
 			var changed bool
-			changed, err = mapRowToDestinationValue(scanContext, concat(groupKey, ":", field.Name), fieldValue, &field)
+			scanContext.pushFieldPath(field.Name)
+
+			if jsonRows, ok := scanContext.jsonRowsElem(fieldMap); ok {
+				if !mapOnlySlices { // JSON rows are already mapped, if the struct was mapped from the previous row
+					changed, err = mapJSONRowsToField(scanContext, jsonRows, fieldValue, field)
+				}
+			} else {
+				changed, err = mapRowToDestinationValue(scanContext, scanContext.getFieldGroupKey(groupKey, i), fieldValue, field)
+			}
+
+			scanContext.popFieldPath()
 
 			if err != nil {
 				return
@@ -249,10 +477,17 @@ func mapRowToStruct(
 			}
 
 		} else {
-			if mapOnlySlices || fieldMap.rowIndex == -1 {
+			if fieldMap.rowIndex == -1 {
+				scanContext.fieldUnfilled(field.Name)
 				continue
 			}
 
+			if mapOnlySlices {
+				continue
+			}
+
+			scanContext.columnMapped(fieldMap.rowIndex)
+
 			scannedValue := scanContext.rowElemValue(fieldMap.rowIndex)
 
 			if !scannedValue.IsValid() {
@@ -262,7 +497,14 @@ func mapRowToStruct(
 
 			updated = true
 
-			if fieldMap.implementsScanner {
+			if fieldMap.decode != noDecode {
+				err := decodeValue(fieldMap.decode, scannedValue, fieldValue)
+
+				if err != nil {
+					return updated, fmt.Errorf(`can't decode %s %T(%q) to '%s %s': %w`, fieldMap.decode, scannedValue.Interface(),
+						scannedValue.Interface(), field.Name, field.Type.String(), err)
+				}
+			} else if fieldMap.implementsScanner {
 				initializeValueIfNilPtr(fieldValue)
 				fieldScanner := getScanner(fieldValue)
 
@@ -270,6 +512,11 @@ func mapRowToStruct(
 
 				err := fieldScanner.Scan(value)
 
+				// scanners usually accept only database/sql driver values, Go values of the field type are assigned
+				if err != nil && scannedValue.Type().AssignableTo(indirectType(fieldValue.Type())) {
+					err = assign(scannedValue, fieldValue)
+				}
+
 				if err != nil {
 					return updated, fmt.Errorf(`can't scan %T(%q) to '%s %s': %w`, value, value, field.Name, field.Type.String(), err)
 				}
@@ -289,7 +536,7 @@ func mapRowToStruct(
 
 func mapRowToDestinationValue(
 	scanContext *ScanContext,
-	groupKey string,
+	groupKey groupKeyHash,
 	dest reflect.Value,
 	structField *reflect.StructField) (updated bool, err error) {
 
@@ -320,7 +567,7 @@ func mapRowToDestinationValue(
 
 func mapRowToDestinationPtr(
 	scanContext *ScanContext,
-	groupKey string,
+	groupKey groupKeyHash,
 	destPtrValue reflect.Value,
 	structField *reflect.StructField) (updated bool, err error) {
 
@@ -332,6 +579,8 @@ func mapRowToDestinationPtr(
 		return mapRowToStruct(scanContext, groupKey, destPtrValue, structField)
 	} else if destValueKind == reflect.Slice {
 		return mapRowToSlice(scanContext, groupKey, destPtrValue, structField)
+	} else if destValueKind == reflect.Map {
+		return mapRowToMap(scanContext, groupKey, destPtrValue, structField)
 	} else {
 		panic("jet: unsupported dest type: " + structField.Name + " " + structField.Type.String())
 	}
@@ -0,0 +1,144 @@
+package qrm
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type BenchFilm struct {
+	FilmID      int64 `sql:"primary_key"`
+	Title       string
+	Description *string
+	ReleaseYear *int32
+	Length      int32
+	Rating      string
+	LastUpdate  time.Time
+}
+
+type BenchActor struct {
+	ActorID    int64 `sql:"primary_key"`
+	FirstName  string
+	LastName   string
+	LastUpdate time.Time
+}
+
+type BenchLanguage struct {
+	LanguageID int64 `sql:"primary_key"`
+	Name       string
+}
+
+const benchQuery = `
+SELECT film.film_id AS "bench_film.film_id",
+       film.title AS "bench_film.title",
+       film.description AS "bench_film.description",
+       film.release_year AS "bench_film.release_year",
+       film.length AS "bench_film.length",
+       film.rating AS "bench_film.rating",
+       film.last_update AS "bench_film.last_update",
+       language.language_id AS "bench_language.language_id",
+       language.name AS "bench_language.name",
+       actor.actor_id AS "bench_actor.actor_id",
+       actor.first_name AS "bench_actor.first_name",
+       actor.last_name AS "bench_actor.last_name",
+       actor.last_update AS "bench_actor.last_update"
+FROM film
+     INNER JOIN language ON language.language_id = film.language_id
+     INNER JOIN film_actor ON film_actor.film_id = film.film_id
+     INNER JOIN actor ON actor.actor_id = film_actor.actor_id
+ORDER BY film.film_id, actor.actor_id`
+
+func openBenchDB(b *testing.B, films, actorsPerFilm int) *sql.DB {
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(b, err)
+	b.Cleanup(func() { _ = db.Close() })
+
+	db.SetMaxOpenConns(1) // each connection to :memory: opens new database
+
+	_, err = db.Exec(`
+CREATE TABLE language (language_id INTEGER PRIMARY KEY, name TEXT NOT NULL);
+CREATE TABLE film (film_id INTEGER PRIMARY KEY, title TEXT NOT NULL, description TEXT, release_year INTEGER,
+                   length INTEGER NOT NULL, rating TEXT NOT NULL, last_update TIMESTAMP NOT NULL, language_id INTEGER NOT NULL);
+CREATE TABLE actor (actor_id INTEGER PRIMARY KEY, first_name TEXT NOT NULL, last_name TEXT NOT NULL, last_update TIMESTAMP NOT NULL);
+CREATE TABLE film_actor (film_id INTEGER NOT NULL, actor_id INTEGER NOT NULL);
+
+INSERT INTO language VALUES (1, 'English'), (2, 'Italian');
+
+WITH RECURSIVE seq(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM seq WHERE n < ?)
+INSERT INTO film SELECT n, 'Film ' || n, 'Description of film ' || n, 2000 + n % 20, 90 + n % 60,
+                        'PG', '2006-02-15 05:03:42', 1 + n % 2 FROM seq;
+
+WITH RECURSIVE seq(n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM seq WHERE n < 200)
+INSERT INTO actor SELECT n, 'First ' || n, 'Last ' || n, '2006-02-15 04:34:33' FROM seq;
+
+WITH RECURSIVE seq(n) AS (SELECT 0 UNION ALL SELECT n + 1 FROM seq WHERE n < ? - 1)
+INSERT INTO film_actor SELECT film.film_id, 1 + (film.film_id + seq.n * 7) % 200 FROM film, seq;
+`, films, actorsPerFilm)
+	require.NoError(b, err)
+
+	return db
+}
+
+func BenchmarkQueryFlatSlice(b *testing.B) {
+	db := openBenchDB(b, 1000, 5)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var dest []struct {
+			BenchFilm
+			BenchActor
+		}
+
+		_, err := Query(context.Background(), db, benchQuery, nil, &dest)
+		require.NoError(b, err)
+		require.Len(b, dest, 5000)
+	}
+}
+
+func BenchmarkQueryNestedSlice(b *testing.B) {
+	db := openBenchDB(b, 1000, 5)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var dest []struct {
+			BenchFilm
+
+			Language BenchLanguage
+			Actors   []BenchActor
+		}
+
+		_, err := Query(context.Background(), db, benchQuery, nil, &dest)
+		require.NoError(b, err)
+		require.Len(b, dest, 1000)
+	}
+}
+
+func BenchmarkQueryTwoLevelNestedSlice(b *testing.B) {
+	db := openBenchDB(b, 1000, 5)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		var dest []struct {
+			BenchLanguage
+
+			Films []struct {
+				BenchFilm
+
+				Actors []BenchActor
+			}
+		}
+
+		_, err := Query(context.Background(), db, benchQuery, nil, &dest)
+		require.NoError(b, err)
+		require.Len(b, dest, 2)
+	}
+}
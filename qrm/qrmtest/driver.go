@@ -0,0 +1,100 @@
+package qrmtest
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+)
+
+// expectationKey is context key of the matched expectation, passed from Mock.QueryContext to the driver connection
+type expectationKey struct{}
+
+// connector and conn are minimal database/sql driver, used only to create *sql.Rows from expectation rows
+type connector struct{}
+
+func (c connector) Connect(context.Context) (driver.Conn, error) {
+	return conn{}, nil
+}
+
+func (c connector) Driver() driver.Driver {
+	return mockDriver{}
+}
+
+type mockDriver struct{}
+
+func (d mockDriver) Open(string) (driver.Conn, error) {
+	return conn{}, nil
+}
+
+type conn struct{}
+
+func (c conn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("jet: prepared statements are not supported by mock")
+}
+
+func (c conn) Close() error {
+	return nil
+}
+
+func (c conn) Begin() (driver.Tx, error) {
+	return nil, errors.New("jet: transactions are not supported by mock")
+}
+
+func (c conn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	expectation, ok := ctx.Value(expectationKey{}).(*Expectation)
+
+	if !ok {
+		return nil, errors.New("jet: query is not matched with mock expectation")
+	}
+
+	resultSets := expectation.resultSets
+
+	if len(resultSets) == 0 {
+		resultSets = []*Rows{NewRows()}
+	}
+
+	return &rows{resultSets: resultSets}, nil
+}
+
+type rows struct {
+	resultSets []*Rows
+	resultSet  int
+	row        int
+}
+
+func (r *rows) Columns() []string {
+	return r.resultSets[r.resultSet].columns
+}
+
+func (r *rows) Close() error {
+	return nil
+}
+
+func (r *rows) Next(dest []driver.Value) error {
+	values := r.resultSets[r.resultSet].values
+
+	if r.row >= len(values) {
+		return io.EOF
+	}
+
+	copy(dest, values[r.row])
+	r.row++
+
+	return nil
+}
+
+func (r *rows) HasNextResultSet() bool {
+	return r.resultSet+1 < len(r.resultSets)
+}
+
+func (r *rows) NextResultSet() error {
+	if !r.HasNextResultSet() {
+		return io.EOF
+	}
+
+	r.resultSet++
+	r.row = 0
+
+	return nil
+}
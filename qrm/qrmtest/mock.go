@@ -0,0 +1,262 @@
+// Package qrmtest provides in-memory implementation of qrm.Queryable and qrm.Executable for unit testing of the code
+// using jet statements, without a database. Mock records every executed sql query with arguments, matches queries with
+// the expectations, and returns expected rows as real *sql.Rows, so that rows are mapped into destination with
+// the same query result mapping (QRM) used with the database.
+package qrmtest
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Statement is a jet statement. It is implemented by all the jet statements.
+type Statement interface {
+	Sql() (query string, args []interface{})
+}
+
+// Call is sql query with arguments executed over the mock
+type Call struct {
+	Query string
+	Args  []interface{}
+}
+
+// Mock is in-memory implementation of qrm.Queryable and qrm.Executable. Each executed query is matched with the first
+// unfulfilled expectation, in the order expectations are added, and expectation result is returned. Query not
+// matching any of the expectations returns an error.
+type Mock struct {
+	mu           sync.Mutex
+	db           *sql.DB
+	calls        []Call
+	expectations []*Expectation
+}
+
+// New creates new Mock
+func New() *Mock {
+	return &Mock{
+		db: sql.OpenDB(connector{}),
+	}
+}
+
+// ExpectQuery adds expectation for sql query. Queries are compared after whitespace normalization, and
+// trailing semicolon is ignored.
+func (m *Mock) ExpectQuery(query string) *Expectation {
+	return m.addExpectation(&Expectation{query: normalizeSql(query), anyArgs: true})
+}
+
+// ExpectStatement adds expectation for jet statement. Executed query matches expectation if it has the same sql
+// (after whitespace normalization) and the same arguments as the statement.
+func (m *Mock) ExpectStatement(statement Statement) *Expectation {
+	query, args := statement.Sql()
+
+	return m.addExpectation(&Expectation{query: normalizeSql(query), args: args})
+}
+
+func (m *Mock) addExpectation(expectation *Expectation) *Expectation {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.expectations = append(m.expectations, expectation)
+
+	return expectation
+}
+
+// Calls returns the list of all the queries executed over the mock, in execution order
+func (m *Mock) Calls() []Call {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]Call{}, m.calls...)
+}
+
+// ExpectationsWereMet returns an error if any of the expectations has not been fulfilled
+func (m *Mock) ExpectationsWereMet() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, expectation := range m.expectations {
+		if !expectation.fulfilled {
+			return fmt.Errorf("jet: expected query has not been executed:\n%s\nargs: %v", expectation.query, expectation.args)
+		}
+	}
+
+	return nil
+}
+
+// QueryContext records query, and returns rows of the matching expectation
+func (m *Mock) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	expectation, err := m.match(query, args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if expectation.err != nil {
+		return nil, expectation.err
+	}
+
+	return m.db.QueryContext(context.WithValue(ctx, expectationKey{}, expectation), query)
+}
+
+// ExecContext records query, and returns result of the matching expectation. If expectation result is not set with
+// WillReturnResult, the result with zero rows affected is returned.
+func (m *Mock) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	expectation, err := m.match(query, args)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if expectation.err != nil {
+		return nil, expectation.err
+	}
+
+	if expectation.result == nil {
+		return driver.RowsAffected(0), nil
+	}
+
+	return expectation.result, nil
+}
+
+func (m *Mock) match(query string, args []interface{}) (*Expectation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls = append(m.calls, Call{Query: query, Args: args})
+
+	normalizedQuery := normalizeSql(query)
+
+	for _, expectation := range m.expectations {
+		if expectation.fulfilled || !expectation.matches(normalizedQuery, args) {
+			continue
+		}
+
+		expectation.fulfilled = true
+
+		return expectation, nil
+	}
+
+	return nil, fmt.Errorf("jet: unexpected query:\n%s\nargs: %v", normalizedQuery, args)
+}
+
+// Expectation is expected sql query with the result mock returns when query is executed
+type Expectation struct {
+	query     string
+	args      []interface{}
+	anyArgs   bool
+	fulfilled bool
+
+	resultSets []*Rows
+	result     sql.Result
+	err        error
+}
+
+// WithArgs sets the list of arguments expected query has to be executed with
+func (e *Expectation) WithArgs(args ...interface{}) *Expectation {
+	e.args = args
+	e.anyArgs = false
+
+	return e
+}
+
+// WillReturnRows sets rows returned by the expected query. If more than one Rows is set, query returns multiple
+// result sets.
+func (e *Expectation) WillReturnRows(rows ...*Rows) *Expectation {
+	e.resultSets = rows
+
+	return e
+}
+
+// WillReturnResult sets the result of the expected statement execution
+func (e *Expectation) WillReturnResult(lastInsertID, rowsAffected int64) *Expectation {
+	e.result = result{lastInsertID: lastInsertID, rowsAffected: rowsAffected}
+
+	return e
+}
+
+// WillReturnError sets the error returned when expected query is executed
+func (e *Expectation) WillReturnError(err error) *Expectation {
+	e.err = err
+
+	return e
+}
+
+func (e *Expectation) matches(normalizedQuery string, args []interface{}) bool {
+	if e.query != normalizedQuery {
+		return false
+	}
+
+	if e.anyArgs {
+		return true
+	}
+
+	if len(e.args) != len(args) {
+		return false
+	}
+
+	for i := range args {
+		if !reflect.DeepEqual(e.args[i], args[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Rows is the list of rows returned by the mock query. Column names are the column aliases
+// the query would return, for instance "film.title".
+type Rows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+// NewRows creates new empty Rows with the list of columns
+func NewRows(columns ...string) *Rows {
+	return &Rows{columns: columns}
+}
+
+// AddRow adds new row with column values. Values are converted to the types database driver would return,
+// for instance int to int64.
+func (r *Rows) AddRow(values ...interface{}) *Rows {
+	if len(values) != len(r.columns) {
+		panic(fmt.Sprintf("jet: row has %d values, expected %d", len(values), len(r.columns)))
+	}
+
+	row := make([]driver.Value, len(values))
+
+	for i, value := range values {
+		var err error
+		row[i], err = driver.DefaultParameterConverter.ConvertValue(value)
+
+		if err != nil {
+			panic(fmt.Sprintf("jet: unsupported value of the column '%s': %s", r.columns[i], err.Error()))
+		}
+	}
+
+	r.values = append(r.values, row)
+
+	return r
+}
+
+func normalizeSql(query string) string {
+	query = strings.Join(strings.Fields(query), " ")
+
+	return strings.TrimSpace(strings.TrimSuffix(query, ";"))
+}
+
+type result struct {
+	lastInsertID int64
+	rowsAffected int64
+}
+
+func (r result) LastInsertId() (int64, error) {
+	return r.lastInsertID, nil
+}
+
+func (r result) RowsAffected() (int64, error) {
+	return r.rowsAffected, nil
+}
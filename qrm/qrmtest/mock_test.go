@@ -0,0 +1,154 @@
+package qrmtest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	film        = postgres.NewTable("public", "film", "", filmID, filmTitle)
+	filmID      = postgres.IntegerColumn("film_id")
+	filmTitle   = postgres.StringColumn("title")
+	actor       = postgres.NewTable("public", "actor", "", actorID, actorName, actorFilmID)
+	actorID     = postgres.IntegerColumn("actor_id")
+	actorName   = postgres.StringColumn("name")
+	actorFilmID = postgres.IntegerColumn("film_id")
+)
+
+type Film struct {
+	FilmID int64 `sql:"primary_key"`
+	Title  string
+
+	Actors []Actor
+}
+
+type Actor struct {
+	ActorID int64 `sql:"primary_key"`
+	Name    string
+}
+
+func TestMockStatementQuery(t *testing.T) {
+	mock := New()
+
+	stmt := postgres.SELECT(filmID, filmTitle, actorID, actorName).
+		FROM(film.INNER_JOIN(actor, actorFilmID.EQ(filmID))).
+		WHERE(filmID.LT(postgres.Int(3)))
+
+	mock.ExpectStatement(stmt).WillReturnRows(
+		NewRows("film.film_id", "film.title", "actor.actor_id", "actor.name").
+			AddRow(1, "Alien", 10, "Sigourney Weaver").
+			AddRow(1, "Alien", 11, "Tom Skerritt").
+			AddRow(2, "Brazil", 12, "Jonathan Pryce"),
+	)
+
+	var dest []Film
+
+	err := stmt.Query(mock, &dest)
+
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+	require.Equal(t, []Film{
+		{FilmID: 1, Title: "Alien", Actors: []Actor{{10, "Sigourney Weaver"}, {11, "Tom Skerritt"}}},
+		{FilmID: 2, Title: "Brazil", Actors: []Actor{{12, "Jonathan Pryce"}}},
+	}, dest)
+
+	query, args := stmt.Sql()
+	require.Equal(t, []Call{{Query: query, Args: args}}, mock.Calls())
+}
+
+func TestMockQueryNormalizedSql(t *testing.T) {
+	mock := New()
+
+	mock.ExpectQuery(`
+		SELECT film.title AS "film.title"
+		FROM public.film
+		WHERE film.film_id = $1
+	`).WillReturnRows(NewRows("film.title").AddRow("Alien"))
+
+	var dest struct {
+		Title string `alias:"film.title"`
+	}
+
+	stmt := postgres.SELECT(filmTitle).FROM(film).WHERE(filmID.EQ(postgres.Int(1)))
+
+	require.NoError(t, stmt.Query(mock, &dest))
+	require.Equal(t, "Alien", dest.Title)
+
+	// expectation is fulfilled, there is no expectation for the second call
+	err := stmt.Query(mock, &dest)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "jet: unexpected query:")
+	require.Len(t, mock.Calls(), 2)
+}
+
+func TestMockArgsMismatch(t *testing.T) {
+	mock := New()
+
+	mock.ExpectStatement(postgres.SELECT(filmTitle).FROM(film).WHERE(filmID.EQ(postgres.Int(1))))
+
+	var dest []Film
+
+	err := postgres.SELECT(filmTitle).FROM(film).WHERE(filmID.EQ(postgres.Int(2))).Query(mock, &dest)
+
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "args: [2]")
+	require.EqualError(t, mock.ExpectationsWereMet(), "jet: expected query has not been executed:\n"+
+		`SELECT film.title AS "film.title" FROM public.film WHERE film.film_id = $1`+"\nargs: [1]")
+}
+
+func TestMockExec(t *testing.T) {
+	mock := New()
+
+	stmt := film.UPDATE(filmTitle).SET(postgres.String("Alien")).WHERE(filmID.EQ(postgres.Int(1)))
+
+	mock.ExpectStatement(stmt).WillReturnResult(0, 1)
+	mock.ExpectStatement(stmt).WillReturnError(errors.New("connection refused"))
+
+	res, err := stmt.Exec(mock)
+	require.NoError(t, err)
+
+	rowsAffected, err := res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), rowsAffected)
+
+	_, err = stmt.Exec(mock)
+	require.EqualError(t, err, "connection refused")
+	require.NoError(t, mock.ExpectationsWereMet())
+
+	t.Run("default result", func(t *testing.T) {
+		mock := New()
+		mock.ExpectStatement(stmt)
+
+		res, err := stmt.Exec(mock)
+		require.NoError(t, err)
+
+		rowsAffected, err := res.RowsAffected()
+		require.NoError(t, err)
+		require.Equal(t, int64(0), rowsAffected)
+	})
+}
+
+func TestMockMultipleResultSets(t *testing.T) {
+	mock := New()
+
+	mock.ExpectQuery("CALL films_and_count()").WillReturnRows(
+		NewRows("film.film_id", "film.title").AddRow(1, "Alien").AddRow(2, "Brazil"),
+		NewRows("count").AddRow(2),
+	)
+
+	var films []Film
+	var count struct {
+		Count int64
+	}
+
+	_, err := qrm.QueryMultiple(context.Background(), mock, "CALL films_and_count()", nil, &films, &count)
+
+	require.NoError(t, err)
+	require.Equal(t, []Film{{FilmID: 1, Title: "Alien"}, {FilmID: 2, Title: "Brazil"}}, films)
+	require.Equal(t, int64(2), count.Count)
+}
@@ -0,0 +1,161 @@
+package qrm
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// multiResultDriver is a test driver returning predefined result sets for a query
+type multiResultDriver struct{}
+
+type multiResultSet struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+var multiResultQueries = map[string][]multiResultSet{
+	"films and count": {
+		{
+			columns: []string{"film.film_id", "film.title"},
+			rows:    [][]driver.Value{{int64(1), "Alien"}, {int64(2), "Brazil"}},
+		},
+		{
+			columns: []string{"count"},
+			rows:    [][]driver.Value{{int64(42)}},
+		},
+		{
+			columns: []string{"language.language_id", "language.name"},
+			rows:    [][]driver.Value{},
+		},
+	},
+}
+
+func (multiResultDriver) Open(name string) (driver.Conn, error) {
+	return multiResultConn{}, nil
+}
+
+type multiResultConn struct{}
+
+func (multiResultConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("not supported")
+}
+
+func (multiResultConn) Close() error {
+	return nil
+}
+
+func (multiResultConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not supported")
+}
+
+func (multiResultConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	resultSets, ok := multiResultQueries[query]
+
+	if !ok {
+		return nil, errors.New("unknown query")
+	}
+
+	return &multiResultRows{resultSets: resultSets}, nil
+}
+
+type multiResultRows struct {
+	resultSets []multiResultSet
+	set, row   int
+}
+
+func (r *multiResultRows) Columns() []string {
+	return r.resultSets[r.set].columns
+}
+
+func (r *multiResultRows) Close() error {
+	return nil
+}
+
+func (r *multiResultRows) Next(dest []driver.Value) error {
+	rows := r.resultSets[r.set].rows
+
+	if r.row >= len(rows) {
+		return io.EOF
+	}
+
+	copy(dest, rows[r.row])
+	r.row++
+
+	return nil
+}
+
+func (r *multiResultRows) HasNextResultSet() bool {
+	return r.set+1 < len(r.resultSets)
+}
+
+func (r *multiResultRows) NextResultSet() error {
+	if !r.HasNextResultSet() {
+		return io.EOF
+	}
+
+	r.set++
+	r.row = 0
+
+	return nil
+}
+
+func init() {
+	sql.Register("qrm_multi_result", multiResultDriver{})
+}
+
+func TestQueryMultiple(t *testing.T) {
+	db, err := sql.Open("qrm_multi_result", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	type Film struct {
+		FilmID int32 `sql:"primary_key"`
+		Title  string
+	}
+
+	type Language struct {
+		LanguageID int32 `sql:"primary_key"`
+		Name       string
+	}
+
+	var films []Film
+	var count struct {
+		Count int64
+	}
+	var languages []Language
+
+	rowsProcessed, err := QueryMultiple(context.Background(), db, "films and count", nil, &films, &count, &languages)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), rowsProcessed)
+	require.Equal(t, []Film{{FilmID: 1, Title: "Alien"}, {FilmID: 2, Title: "Brazil"}}, films)
+	require.Equal(t, int64(42), count.Count)
+	require.Empty(t, languages)
+
+	t.Run("struct destination of empty result set", func(t *testing.T) {
+		var language Language
+
+		_, err := QueryMultiple(context.Background(), db, "films and count", nil, &films, &count, &language)
+		require.ErrorIs(t, err, ErrNoRows)
+		require.EqualError(t, err, "jet: result set 2: qrm: no rows in result set")
+	})
+
+	t.Run("too many destinations", func(t *testing.T) {
+		var extra []Film
+
+		_, err := QueryMultiple(context.Background(), db, "films and count", nil, &films, &count, &languages, &extra)
+		require.EqualError(t, err, "jet: query returned 3 result sets, expected 4")
+	})
+
+	t.Run("invalid destination", func(t *testing.T) {
+		require.PanicsWithValue(t, "jet: destination has to be a pointer to slice or pointer to struct", func() {
+			var number int64
+			_, _ = QueryMultiple(context.Background(), db, "films and count", nil, &films, &number)
+		})
+	})
+}
@@ -1,7 +1,6 @@
 package qrm
 
 import (
-	"database/sql"
 	"fmt"
 	"reflect"
 	"strings"
@@ -12,28 +11,33 @@ import (
 type ScanContext struct {
 	rowNum                   int64
 	row                      []interface{}
-	uniqueDestObjectsMap     map[string]int
+	columnAliases            []string
+	columnsKey               string
+	uniqueDestObjectsMap     map[groupKeyHash]int
+	uniqueDestMapEntries     map[groupKeyHash]reflect.Value
+	destMapKeys              []reflect.Value // keys of destination map entries created or replaced by the scan
 	commonIdentToColumnIndex map[string]int
-	groupKeyInfoCache        map[string]groupKeyInfo
-	typeInfoMap              map[string]typeInfo
+	groupKeyInfoCache        map[typeCacheKey]interface{}
+	mapKeyIndexesCache       map[typeCacheKey]interface{}
+	typeInfoMap              map[typeCacheKey]interface{}
+	groupKeyHasher           groupKeyHasher
+	strictScan               *strictScanInfo // nil if strict scan mode is off
 
 	typesVisited typeStack // to prevent circular dependency scan
 }
 
 // NewScanContext creates new ScanContext from rows
-func NewScanContext(rows *sql.Rows) (*ScanContext, error) {
+func NewScanContext(rows RowSource) (*ScanContext, error) {
 	aliases, err := rows.Columns()
 
 	if err != nil {
 		return nil, err
 	}
 
-	columnTypes, err := rows.ColumnTypes()
-
-	if err != nil {
-		return nil, err
-	}
+	return newScanContext(aliases), nil
+}
 
+func newScanContext(aliases []string) *ScanContext {
 	commonIdentToColumnIndex := map[string]int{}
 
 	for i, alias := range aliases {
@@ -48,16 +52,21 @@ func NewScanContext(rows *sql.Rows) (*ScanContext, error) {
 	}
 
 	return &ScanContext{
-		row:                  createScanSlice(len(columnTypes)),
-		uniqueDestObjectsMap: make(map[string]int),
-
-		groupKeyInfoCache:        make(map[string]groupKeyInfo),
+		row:                  createScanSlice(len(aliases)),
+		columnAliases:        aliases,
+		columnsKey:           strings.Join(aliases, "\x00"),
+		uniqueDestObjectsMap: make(map[groupKeyHash]int),
+		uniqueDestMapEntries: make(map[groupKeyHash]reflect.Value),
+
+		groupKeyInfoCache:        make(map[typeCacheKey]interface{}),
+		mapKeyIndexesCache:       make(map[typeCacheKey]interface{}),
 		commonIdentToColumnIndex: commonIdentToColumnIndex,
 
-		typeInfoMap: make(map[string]typeInfo),
+		typeInfoMap:    make(map[typeCacheKey]interface{}),
+		groupKeyHasher: newGroupKeyHasher(),
 
 		typesVisited: newTypeStack(),
-	}, nil
+	}
 }
 
 func createScanSlice(columnCount int) []interface{} {
@@ -76,23 +85,23 @@ type typeInfo struct {
 }
 
 type fieldMapping struct {
+	field             reflect.StructField
 	complexType       bool // slice and struct are complex types
 	rowIndex          int  // index in ScanContext.row
 	implementsScanner bool
+	decode            fieldDecode // json or array decoding of the column value, set with field sql tag
+	jsonRows          bool        // complex field with matching column, that can contain JSON aggregated rows
 }
 
 func (s *ScanContext) getTypeInfo(structType reflect.Type, parentField *reflect.StructField) typeInfo {
+	key := newTypeCacheKey(structType, parentField)
 
-	typeMapKey := structType.String()
-
-	if parentField != nil {
-		typeMapKey = concat(typeMapKey, string(parentField.Tag))
-	}
-
-	if typeInfo, ok := s.typeInfoMap[typeMapKey]; ok {
-		return typeInfo
-	}
+	return s.loadOrCreate(s.typeInfoMap, &globalTypeInfoCache, key, func() interface{} {
+		return s.newTypeInfo(structType, parentField)
+	}).(typeInfo)
+}
 
+func (s *ScanContext) newTypeInfo(structType reflect.Type, parentField *reflect.StructField) typeInfo {
 	typeName := getTypeName(structType, parentField)
 
 	newTypeInfo := typeInfo{}
@@ -104,20 +113,24 @@ func (s *ScanContext) getTypeInfo(structType reflect.Type, parentField *reflect.
 		columnIndex := s.typeToColumnIndex(newTypeName, fieldName)
 
 		fieldMap := fieldMapping{
+			field:    field,
 			rowIndex: columnIndex,
+			decode:   getFieldDecode(field),
 		}
 
-		if implementsScannerType(field.Type) {
+		switch {
+		case fieldMap.decode != noDecode:
+			// decoded fields are mapped from a single column, regardless of the field type
+		case implementsScannerType(field.Type):
 			fieldMap.implementsScanner = true
-		} else if !isSimpleModelType(field.Type) {
+		case !isSimpleModelType(field.Type):
 			fieldMap.complexType = true
+			fieldMap.jsonRows = columnIndex != -1 && isJSONRowsType(field.Type)
 		}
 
 		newTypeInfo.fieldMappings = append(newTypeInfo.fieldMappings, fieldMap)
 	}
 
-	s.typeInfoMap[typeMapKey] = newTypeInfo
-
 	return newTypeInfo
 }
 
@@ -127,43 +140,59 @@ type groupKeyInfo struct {
 	subTypes  []groupKeyInfo
 }
 
-func (s *ScanContext) getGroupKey(structType reflect.Type, structField *reflect.StructField) string {
+// getGroupKey returns group key of the structType object, constructed from the parent group key and the current row
+// values of the structType primary key columns. If structType has no primary key columns, each row is a separate group.
+func (s *ScanContext) getGroupKey(parent groupKeyHash, structType reflect.Type, structField *reflect.StructField) groupKeyHash {
+	key := newTypeCacheKey(structType, structField)
 
-	mapKey := structType.Name()
-
-	if structField != nil {
-		mapKey = concat(mapKey, structField.Type.String())
-	}
-
-	if groupKeyInfo, ok := s.groupKeyInfoCache[mapKey]; ok {
-		return s.constructGroupKey(groupKeyInfo)
-	}
+	groupKeyInfo := s.loadOrCreate(s.groupKeyInfoCache, &globalGroupKeyInfoCache, key, func() interface{} {
+		tempTypeStack := newTypeStack()
+		return s.getGroupKeyInfo(structType, structField, &tempTypeStack)
+	}).(groupKeyInfo)
 
-	tempTypeStack := newTypeStack()
-	groupKeyInfo := s.getGroupKeyInfo(structType, structField, &tempTypeStack)
+	s.groupKeyHasher.start(parent, groupKeyTagStruct)
+	s.writeGroupKey(groupKeyInfo)
 
-	s.groupKeyInfoCache[mapKey] = groupKeyInfo
-
-	return s.constructGroupKey(groupKeyInfo)
+	return s.groupKeyHasher.sum()
 }
 
-func (s *ScanContext) constructGroupKey(groupKeyInfo groupKeyInfo) string {
+func (s *ScanContext) writeGroupKey(groupKeyInfo groupKeyInfo) {
 	if len(groupKeyInfo.pkIndexes) == 0 && len(groupKeyInfo.subTypes) == 0 {
-		return fmt.Sprintf("|ROW:%d|", s.rowNum)
+		s.groupKeyHasher.writeByte(groupKeyTagRowNum)
+		s.groupKeyHasher.writeUint64(uint64(s.rowNum))
+		return
 	}
 
-	var groupKeys []string
+	s.groupKeyHasher.writeString(groupKeyInfo.typeName)
 
 	for _, index := range groupKeyInfo.pkIndexes {
-		groupKeys = append(groupKeys, s.rowElemToString(index))
+		s.groupKeyHasher.writeValue(s.rowElem(index))
 	}
 
-	var subTypesGroupKeys []string
 	for _, subType := range groupKeyInfo.subTypes {
-		subTypesGroupKeys = append(subTypesGroupKeys, s.constructGroupKey(subType))
+		s.groupKeyHasher.writeByte(groupKeyTagSubTypeStart)
+		s.writeGroupKey(subType)
+		s.groupKeyHasher.writeByte(groupKeyTagSubTypeEnd)
+	}
+}
+
+// getFieldGroupKey returns group key of the destination struct field at fieldIndex
+func (s *ScanContext) getFieldGroupKey(parent groupKeyHash, fieldIndex int) groupKeyHash {
+	s.groupKeyHasher.start(parent, groupKeyTagField)
+	s.groupKeyHasher.writeUint64(uint64(fieldIndex))
+
+	return s.groupKeyHasher.sum()
+}
+
+// getMapEntryGroupKey returns group key of the map entry, constructed from the current row values at keyIndexes
+func (s *ScanContext) getMapEntryGroupKey(parent groupKeyHash, keyIndexes []int) groupKeyHash {
+	s.groupKeyHasher.start(parent, groupKeyTagMapEntry)
+
+	for _, index := range keyIndexes {
+		s.groupKeyHasher.writeValue(s.rowElem(index))
 	}
 
-	return concat(groupKeyInfo.typeName, "(", strings.Join(groupKeys, ","), strings.Join(subTypesGroupKeys, ","), ")")
+	return s.groupKeyHasher.sum()
 }
 
 func (s *ScanContext) getGroupKeyInfo(
@@ -173,11 +202,11 @@ func (s *ScanContext) getGroupKeyInfo(
 
 	ret := groupKeyInfo{typeName: structType.Name()}
 
-	if typeVisited.contains(&structType) {
+	if typeVisited.contains(structType) {
 		return ret
 	}
 
-	typeVisited.push(&structType)
+	typeVisited.push(structType)
 	defer typeVisited.pop()
 
 	typeName := getTypeName(structType, parentField)
@@ -198,7 +227,7 @@ func (s *ScanContext) getGroupKeyInfo(
 
 			ret.pkIndexes = append(ret.pkIndexes, pkIndex)
 
-		} else if fieldType.Kind() == reflect.Struct && fieldType != timeType {
+		} else if fieldType.Kind() == reflect.Struct && fieldType != timeType && getFieldDecode(field) == noDecode {
 
 			subType := s.getGroupKeyInfo(fieldType, &field, typeVisited)
 
@@ -211,6 +240,54 @@ func (s *ScanContext) getGroupKeyInfo(
 	return ret
 }
 
+// getMapKeyIndexes returns row indexes of the columns used to construct map keys for map values of structType.
+// Map key columns are listed in the parent field `sql:"map_key=Field1,Field2"` tag, otherwise structType
+// primary key columns are used.
+func (s *ScanContext) getMapKeyIndexes(structType reflect.Type, parentField *reflect.StructField) []int {
+	key := newTypeCacheKey(structType, parentField)
+
+	return s.loadOrCreate(s.mapKeyIndexesCache, &globalMapKeyIndexesCache, key, func() interface{} {
+		return s.newMapKeyIndexes(structType, parentField)
+	}).([]int)
+}
+
+func (s *ScanContext) newMapKeyIndexes(structType reflect.Type, parentField *reflect.StructField) []int {
+	var indexes []int
+
+	if mapKeyFields := parentFieldMapKey(parentField); len(mapKeyFields) > 0 {
+		typeName := getTypeName(structType, parentField)
+
+		for _, fieldName := range mapKeyFields {
+			field, ok := structType.FieldByName(fieldName)
+
+			if !ok {
+				continue
+			}
+
+			newTypeName, columnName := getTypeAndFieldName(typeName, field)
+
+			if index := s.typeToColumnIndex(newTypeName, columnName); index >= 0 {
+				indexes = append(indexes, index)
+			}
+		}
+	} else {
+		tempTypeStack := newTypeStack()
+		indexes = s.getGroupKeyInfo(structType, parentField, &tempTypeStack).allPkIndexes()
+	}
+
+	return indexes
+}
+
+func (g groupKeyInfo) allPkIndexes() []int {
+	ret := append([]int{}, g.pkIndexes...)
+
+	for _, subType := range g.subTypes {
+		ret = append(ret, subType.allPkIndexes()...)
+	}
+
+	return ret
+}
+
 func (s *ScanContext) typeToColumnIndex(typeName, fieldName string) int {
 	var key string
 
@@ -236,20 +313,59 @@ func (s *ScanContext) rowElemValue(index int) reflect.Value {
 	return scannedValue.Elem().Elem() // no need to check validity of Elem, because s.row[index] always contains interface in interface
 }
 
-func (s *ScanContext) rowElemToString(index int) string {
-	value := s.rowElemValue(index)
+// rowElem returns scanned row value at index, nil if value is NULL
+func (s *ScanContext) rowElem(index int) interface{} {
+	return *s.row[index].(*interface{})
+}
+
+// constructMapKey returns map key of mapKeyType, constructed from the row values at keyIndexes.
+// If all the row key values are NULL, returned map key is invalid.
+func (s *ScanContext) constructMapKey(mapKeyType reflect.Type, keyIndexes []int) (mapKey reflect.Value, err error) {
+	allNulls := true
+
+	for _, index := range keyIndexes {
+		if s.rowElem(index) != nil {
+			allNulls = false
+		}
+	}
 
-	if !value.IsValid() {
-		return "nil"
+	if allNulls {
+		return reflect.Value{}, nil
 	}
 
-	valueInterface := value.Interface()
+	mapKey = reflect.New(mapKeyType).Elem()
+
+	if len(keyIndexes) == 1 {
+		keyValue := s.rowElemValue(keyIndexes[0])
+
+		if keyValue.IsValid() {
+			err = assign(keyValue, mapKey)
+		}
+	} else if mapKeyType.Kind() == reflect.String {
+		var keyStrings []string
+
+		for _, index := range keyIndexes {
+			var keyString string
 
-	if t, ok := valueInterface.(fmt.Stringer); ok {
-		return t.String()
+			if keyValue := s.rowElemValue(index); keyValue.IsValid() {
+				if err = assign(keyValue, reflect.ValueOf(&keyString).Elem()); err != nil {
+					break
+				}
+			}
+
+			keyStrings = append(keyStrings, keyString)
+		}
+
+		mapKey.SetString(strings.Join(keyStrings, ","))
+	} else {
+		err = fmt.Errorf("map key type %s can't hold values of %d key columns", mapKeyType.String(), len(keyIndexes))
+	}
+
+	if err != nil {
+		return reflect.Value{}, fmt.Errorf("can't construct map key: %w", err)
 	}
 
-	return fmt.Sprintf("%#v", valueInterface)
+	return mapKey, nil
 }
 
 func (s *ScanContext) rowElemValueClonePtr(index int) reflect.Value {
@@ -0,0 +1,148 @@
+package qrm
+
+import (
+	"context"
+	"strings"
+)
+
+// Config holds query result mapping configuration
+type Config struct {
+	// StrictScan enables strict scan mode for all the queries. In strict scan mode query result mapping fails with
+	// StrictScanError if some of the result set columns are not mapped to any destination field, or if some of
+	// the destination fields do not have matching result set column.
+	StrictScan bool
+}
+
+// GlobalConfig is query result mapping configuration used by all the queries
+var GlobalConfig = Config{}
+
+type strictScanContextKey struct{}
+
+// WithStrictScan returns a copy of ctx with strict scan mode turned on or off. Queries executed with returned
+// context use this setting instead of GlobalConfig.StrictScan.
+func WithStrictScan(ctx context.Context, strict bool) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	return context.WithValue(ctx, strictScanContextKey{}, strict)
+}
+
+// IsStrictScan reports whether strict scan mode is on for queries executed with ctx
+func IsStrictScan(ctx context.Context) bool {
+	if ctx != nil {
+		if strict, ok := ctx.Value(strictScanContextKey{}).(bool); ok {
+			return strict
+		}
+	}
+
+	return GlobalConfig.StrictScan
+}
+
+// StrictScanError is returned by query result mapping in strict scan mode, if some of the result set columns
+// are not mapped to any destination field, or if some of the destination fields do not have matching column.
+type StrictScanError struct {
+	// UnmappedColumns contains aliases of the result set columns not mapped to any destination field
+	UnmappedColumns []string
+	// UnfilledFields contains dotted paths (through nested structs) of destination fields without matching column
+	UnfilledFields []string
+}
+
+func (e *StrictScanError) Error() string {
+	var messages []string
+
+	if len(e.UnmappedColumns) > 0 {
+		messages = append(messages, "columns not mapped to any destination field: "+strings.Join(e.UnmappedColumns, ", "))
+	}
+
+	if len(e.UnfilledFields) > 0 {
+		messages = append(messages, "destination fields without matching column: "+strings.Join(e.UnfilledFields, ", "))
+	}
+
+	return "strict scan: " + strings.Join(messages, "; ")
+}
+
+// strictScanInfo tracks result set columns and destination fields mapped by query result mapping
+type strictScanInfo struct {
+	fieldPath         []string
+	mappedColumns     []bool
+	unfilledFields    []string
+	unfilledFieldsSet map[string]bool
+}
+
+// SetStrictScan turns strict scan mode on or off for this scan context
+func (s *ScanContext) SetStrictScan(strict bool) {
+	if !strict {
+		s.strictScan = nil
+		return
+	}
+
+	s.strictScan = &strictScanInfo{
+		mappedColumns:     make([]bool, len(s.row)),
+		unfilledFieldsSet: make(map[string]bool),
+	}
+}
+
+func (s *ScanContext) pushFieldPath(fieldName string) {
+	if s.strictScan != nil {
+		s.strictScan.fieldPath = append(s.strictScan.fieldPath, fieldName)
+	}
+}
+
+func (s *ScanContext) popFieldPath() {
+	if s.strictScan != nil {
+		s.strictScan.fieldPath = s.strictScan.fieldPath[:len(s.strictScan.fieldPath)-1]
+	}
+}
+
+func (s *ScanContext) columnMapped(index int) {
+	if s.strictScan != nil && index >= 0 {
+		s.strictScan.mappedColumns[index] = true
+	}
+}
+
+// fieldUnfilled records destination field without matching column. Field path is constructed from the current
+// field path and fieldName, if fieldName is not empty.
+func (s *ScanContext) fieldUnfilled(fieldName string) {
+	if s.strictScan == nil {
+		return
+	}
+
+	path := s.strictScan.fieldPath
+
+	if fieldName != "" {
+		path = append(path[:len(path):len(path)], fieldName)
+	}
+
+	fieldPath := strings.Join(path, ".")
+
+	if !s.strictScan.unfilledFieldsSet[fieldPath] {
+		s.strictScan.unfilledFieldsSet[fieldPath] = true
+		s.strictScan.unfilledFields = append(s.strictScan.unfilledFields, fieldPath)
+	}
+}
+
+// strictScanError returns StrictScanError if strict scan mode is on, and some of the columns or destination fields
+// processed so far are not mapped.
+func (s *ScanContext) strictScanError() error {
+	if s.strictScan == nil || s.rowNum == 0 {
+		return nil
+	}
+
+	var unmappedColumns []string
+
+	for index, mapped := range s.strictScan.mappedColumns {
+		if !mapped {
+			unmappedColumns = append(unmappedColumns, s.columnAliases[index])
+		}
+	}
+
+	if len(unmappedColumns) == 0 && len(s.strictScan.unfilledFields) == 0 {
+		return nil
+	}
+
+	return &StrictScanError{
+		UnmappedColumns: unmappedColumns,
+		UnfilledFields:  s.strictScan.unfilledFields,
+	}
+}
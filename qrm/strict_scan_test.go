@@ -0,0 +1,101 @@
+package qrm
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+const strictScanQuery = `
+SELECT 1 AS "film.film_id", 'Alien' AS "film.titel", 10 AS "actor.actor_id", 'Sigourney' AS "actor.first_name"
+UNION ALL
+SELECT 1, 'Alien', 11, 'Tom'`
+
+func openMemoryDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	return db
+}
+
+func TestStrictScan(t *testing.T) {
+	db := openMemoryDB(t)
+
+	type Film struct {
+		FilmID int64 `sql:"primary_key"`
+		Title  string
+	}
+
+	type Actor struct {
+		ActorID   int64 `sql:"primary_key"`
+		FirstName string
+	}
+
+	var dest []struct {
+		Film
+
+		Actors []Actor
+	}
+
+	t.Run("strict scan off", func(t *testing.T) {
+		_, err := Query(context.Background(), db, strictScanQuery, nil, &dest)
+		require.NoError(t, err)
+		require.Len(t, dest, 1)
+		require.Equal(t, "", dest[0].Title)
+		require.Len(t, dest[0].Actors, 2)
+	})
+
+	t.Run("strict scan per call", func(t *testing.T) {
+		_, err := Query(WithStrictScan(context.Background(), true), db, strictScanQuery, nil, &dest)
+		require.EqualError(t, err, "jet: strict scan: columns not mapped to any destination field: film.titel; "+
+			"destination fields without matching column: Film.Title")
+
+		var strictScanErr *StrictScanError
+		require.True(t, errors.As(err, &strictScanErr))
+		require.Equal(t, []string{"film.titel"}, strictScanErr.UnmappedColumns)
+		require.Equal(t, []string{"Film.Title"}, strictScanErr.UnfilledFields)
+	})
+
+	t.Run("strict scan global", func(t *testing.T) {
+		GlobalConfig.StrictScan = true
+		defer func() { GlobalConfig.StrictScan = false }()
+
+		_, err := Query(context.Background(), db, strictScanQuery, nil, &dest)
+		require.Error(t, err)
+
+		_, err = Query(WithStrictScan(context.Background(), false), db, strictScanQuery, nil, &dest)
+		require.NoError(t, err)
+	})
+
+	t.Run("nested fields path", func(t *testing.T) {
+		var dest struct {
+			Film struct {
+				FilmID int64  `sql:"primary_key"`
+				Title  string `alias:"film.titel"`
+				Actors []struct {
+					Actor
+
+					LastName string `alias:"actor.last_name"`
+				}
+				ActorIDs []int64 `alias:"actor.id"`
+			} `alias:"film"`
+		}
+
+		_, err := Query(WithStrictScan(context.Background(), true), db, strictScanQuery, nil, &dest)
+		require.EqualError(t, err, "jet: strict scan: destination fields without matching column: "+
+			"Film.Actors.LastName, Film.ActorIDs")
+	})
+
+	t.Run("all columns mapped", func(t *testing.T) {
+		var dest []map[string]interface{}
+
+		_, err := Query(WithStrictScan(context.Background(), true), db, strictScanQuery, nil, &dest)
+		require.NoError(t, err)
+		require.Len(t, dest, 2)
+	})
+}
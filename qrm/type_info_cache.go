@@ -0,0 +1,61 @@
+package qrm
+
+import (
+	"reflect"
+	"sync"
+)
+
+// typeCacheKey identifies mapping of the destination struct type. Mapping of the struct type depends on
+// the parent field tag (alias, primary_key and map_key settings), and on the list of result set columns.
+type typeCacheKey struct {
+	structType reflect.Type
+	parentTag  reflect.StructTag
+}
+
+func newTypeCacheKey(structType reflect.Type, parentField *reflect.StructField) typeCacheKey {
+	ret := typeCacheKey{structType: structType}
+
+	if parentField != nil {
+		ret.parentTag = parentField.Tag
+	}
+
+	return ret
+}
+
+type globalTypeCacheKey struct {
+	typeCacheKey
+	columns string // result set column aliases, separated with \x00
+}
+
+// Global, process-wide caches of struct types mappings. Each query result set column list is cached separately, so the
+// cache size is proportional to the number of distinct (destination type, query projection) pairs used by the program.
+var (
+	globalTypeInfoCache      sync.Map // globalTypeCacheKey -> typeInfo
+	globalGroupKeyInfoCache  sync.Map // globalTypeCacheKey -> groupKeyInfo
+	globalMapKeyIndexesCache sync.Map // globalTypeCacheKey -> []int
+)
+
+// loadOrCreate returns value cached in the scan context local cache, or in the global cache if value is not in the local
+// cache. If value is not in any of the caches, value is created with create function and stored in both of the caches.
+func (s *ScanContext) loadOrCreate(
+	localCache map[typeCacheKey]interface{},
+	globalCache *sync.Map,
+	key typeCacheKey,
+	create func() interface{}) interface{} {
+
+	if value, ok := localCache[key]; ok {
+		return value
+	}
+
+	globalKey := globalTypeCacheKey{typeCacheKey: key, columns: s.columnsKey}
+
+	value, ok := globalCache.Load(globalKey)
+
+	if !ok {
+		value, _ = globalCache.LoadOrStore(globalKey, create())
+	}
+
+	localCache[key] = value
+
+	return value
+}
@@ -0,0 +1,93 @@
+package qrm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypeInfoCacheColumnSets(t *testing.T) {
+	db := openMemoryDB(t)
+
+	type Film struct {
+		FilmID int64 `sql:"primary_key"`
+		Title  string
+	}
+
+	var dest []Film
+
+	_, err := Query(context.Background(), db, `SELECT 1 AS "film.film_id", 'Alien' AS "film.title"`, nil, &dest)
+	require.NoError(t, err)
+	require.Equal(t, []Film{{FilmID: 1, Title: "Alien"}}, dest)
+
+	dest = nil
+
+	_, err = Query(context.Background(), db, `SELECT 'Aliens' AS "film.title", 2 AS "film.film_id"`, nil, &dest)
+	require.NoError(t, err)
+	require.Equal(t, []Film{{FilmID: 2, Title: "Aliens"}}, dest)
+}
+
+func TestTypeInfoCacheSameTypeName(t *testing.T) {
+	db := openMemoryDB(t)
+	query := `SELECT 1 AS "film.film_id", 'Alien' AS "film.title", 'R' AS "film.rating"`
+
+	{
+		type Film struct {
+			FilmID int64 `sql:"primary_key"`
+			Title  string
+		}
+
+		var dest []Film
+
+		_, err := Query(context.Background(), db, query, nil, &dest)
+		require.NoError(t, err)
+		require.Equal(t, []Film{{FilmID: 1, Title: "Alien"}}, dest)
+	}
+
+	{
+		type Film struct {
+			Rating string
+			FilmID int64 `sql:"primary_key"`
+		}
+
+		var dest []Film
+
+		_, err := Query(context.Background(), db, query, nil, &dest)
+		require.NoError(t, err)
+		require.Equal(t, []Film{{Rating: "R", FilmID: 1}}, dest)
+	}
+}
+
+func TestNestedGroupingWithNulls(t *testing.T) {
+	db := openMemoryDB(t)
+
+	type Actor struct {
+		ActorID int64 `sql:"primary_key"`
+		Name    string
+	}
+
+	type Film struct {
+		FilmID int64  `sql:"primary_key"`
+		Lang   string `sql:"primary_key"`
+	}
+
+	var dest []struct {
+		Film
+
+		Actors []Actor
+	}
+
+	_, err := Query(context.Background(), db, `
+SELECT 1 AS "film.film_id", 'en' AS "film.lang", 10 AS "actor.actor_id", 'Tom' AS "actor.name"
+UNION ALL SELECT 1, 'en', 11, 'Ann'
+UNION ALL SELECT 1, 'it', 10, 'Tom'
+UNION ALL SELECT 2, 'en', NULL, NULL
+UNION ALL SELECT 1, 'en', 10, 'Tom'`, nil, &dest)
+
+	require.NoError(t, err)
+	require.Len(t, dest, 3)
+	require.Equal(t, []Actor{{10, "Tom"}, {11, "Ann"}}, dest[0].Actors)
+	require.Equal(t, []Actor{{10, "Tom"}}, dest[1].Actors)
+	require.Empty(t, dest[2].Actors)
+}
@@ -2,7 +2,7 @@ package qrm
 
 import "reflect"
 
-type typeStack []*reflect.Type
+type typeStack []reflect.Type
 
 func newTypeStack() typeStack {
 	stack := make(typeStack, 0, 20)
@@ -13,7 +13,7 @@ func (s *typeStack) isEmpty() bool {
 	return len(*s) == 0
 }
 
-func (s *typeStack) push(t *reflect.Type) {
+func (s *typeStack) push(t reflect.Type) {
 	*s = append(*s, t)
 }
 
@@ -25,13 +25,13 @@ func (s *typeStack) pop() bool {
 	return true
 }
 
-func (s *typeStack) contains(t *reflect.Type) bool {
+func (s *typeStack) contains(t reflect.Type) bool {
 	if s.isEmpty() {
 		return false
 	}
 
 	for _, typ := range *s {
-		if *typ == *t {
+		if typ == t {
 			return true
 		}
 	}
@@ -309,9 +309,46 @@ func isPrimaryKey(field reflect.StructField, primaryKeyOverwrites []string) bool
 		return utils.StringSliceContains(primaryKeyOverwrites, field.Name)
 	}
 
-	sqlTag := field.Tag.Get("sql")
+	values, ok := sqlTagOptions(field)["primary_key"]
 
-	return sqlTag == "primary_key"
+	return ok && len(values) == 0
+}
+
+// sqlTagOptionNames are the names of the field sql tag options
+var sqlTagOptionNames = []string{"primary_key", "map_key", "json", "array"}
+
+// sqlTagOptions returns comma separated options from the field sql tag, for instance `sql:"primary_key,json"`, mapped
+// to option values. Option values follow equal sign and are separated by comma as well, for instance
+// `sql:"map_key=Code,Name,json"`, so each value that is not an option name belongs to the previous option.
+func sqlTagOptions(field reflect.StructField) map[string][]string {
+	options := map[string][]string{}
+	lastOption := ""
+
+	for _, part := range strings.Split(field.Tag.Get("sql"), ",") {
+		part = strings.TrimSpace(part)
+
+		if part == "" {
+			continue
+		}
+
+		name, value, hasValue := strings.Cut(part, "=")
+		name = strings.TrimSpace(name)
+
+		if !hasValue && lastOption != "" && !utils.StringSliceContains(sqlTagOptionNames, name) {
+			options[lastOption] = append(options[lastOption], name)
+			continue
+		}
+
+		options[name] = nil
+		lastOption = ""
+
+		if hasValue {
+			options[name] = append(options[name], strings.TrimSpace(value))
+			lastOption = name
+		}
+	}
+
+	return options
 }
 
 func parentFieldPrimaryKeyOverwrite(parentField *reflect.StructField) []string {
@@ -319,19 +356,29 @@ func parentFieldPrimaryKeyOverwrite(parentField *reflect.StructField) []string {
 		return nil
 	}
 
-	sqlTag := parentField.Tag.Get("sql")
+	return sqlTagOptions(*parentField)["primary_key"]
+}
 
-	if !strings.HasPrefix(sqlTag, "primary_key") {
+func parentFieldMapKey(parentField *reflect.StructField) []string {
+	if parentField == nil {
 		return nil
 	}
 
-	parts := strings.Split(sqlTag, "=")
+	return sqlTagOptions(*parentField)["map_key"]
+}
 
-	if len(parts) < 2 {
-		return nil
+// isRowMapType returns true for map types with string key and non-struct value, like map[string]interface{},
+// where each row is mapped into a new map of column names and values
+func isRowMapType(objType reflect.Type) bool {
+	objType = indirectType(objType)
+
+	if objType.Kind() != reflect.Map || objType.Key().Kind() != reflect.String {
+		return false
 	}
 
-	return strings.Split(parts[1], ",")
+	valueType := objType.Elem()
+
+	return valueType.Kind() == reflect.Interface || isSimpleModelType(valueType)
 }
 
 func indirectType(reflectType reflect.Type) reflect.Type {
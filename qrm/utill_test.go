@@ -36,6 +36,46 @@ func TestIsSimpleModelType(t *testing.T) {
 	require.Equal(t, isSimpleModelType(reflect.TypeOf([]int{1, 2})), false)
 }
 
+func TestIsRowMapType(t *testing.T) {
+	require.True(t, isRowMapType(reflect.TypeOf(map[string]interface{}{})))
+	require.True(t, isRowMapType(reflect.TypeOf(&map[string]interface{}{})))
+	require.True(t, isRowMapType(reflect.TypeOf(map[string]string{})))
+	require.True(t, isRowMapType(reflect.TypeOf(map[string]int64{})))
+
+	require.False(t, isRowMapType(reflect.TypeOf(map[int]interface{}{})))
+	require.False(t, isRowMapType(reflect.TypeOf(map[string]struct{ Field1 string }{})))
+	require.False(t, isRowMapType(reflect.TypeOf(map[string][]struct{ Field1 string }{})))
+	require.False(t, isRowMapType(reflect.TypeOf([]string{"str"})))
+}
+
+func TestSqlTagOptions(t *testing.T) {
+	type tagged struct {
+		ID       int64                         `sql:"primary_key"`
+		JsonID   int64                         `sql:"json, primary_key"`
+		Children []struct{ ID int64 }          `sql:"primary_key=ID,Code"`
+		Entries  map[string]struct{ ID int64 } `sql:"map_key=Code,Name,json"`
+		Data     []string                      `sql:"json,map_key=Code"`
+	}
+
+	field := func(name string) reflect.StructField {
+		field, _ := reflect.TypeOf(tagged{}).FieldByName(name)
+		return field
+	}
+
+	require.True(t, isPrimaryKey(field("ID"), nil))
+	require.True(t, isPrimaryKey(field("JsonID"), nil))
+	require.False(t, isPrimaryKey(field("Children"), nil))
+	require.Equal(t, jsonDecode, getFieldDecode(field("JsonID")))
+	require.Equal(t, jsonDecode, getFieldDecode(field("Entries")))
+	require.Equal(t, noDecode, getFieldDecode(field("Children")))
+
+	children, entries, data := field("Children"), field("Entries"), field("Data")
+	require.Equal(t, []string{"ID", "Code"}, parentFieldPrimaryKeyOverwrite(&children))
+	require.Equal(t, []string{"Code", "Name"}, parentFieldMapKey(&entries))
+	require.Equal(t, []string{"Code"}, parentFieldMapKey(&data))
+	require.Nil(t, parentFieldPrimaryKeyOverwrite(&entries))
+}
+
 func TestTryAssign(t *testing.T) {
 	convertible := int16(16)
 	intBool1 := int32(1)
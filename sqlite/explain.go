@@ -0,0 +1,114 @@
+package sqlite
+
+import (
+	"database/sql"
+	"strings"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// ExplainStatement is a statement showing the execution plan of explained statement
+type ExplainStatement = jet.ExplainStatement
+
+// QueryPlan is statement query plan, parsed from EXPLAIN statement output
+type QueryPlan = jet.QueryPlan
+
+// PlanNode is a node of the statement query plan tree
+type PlanNode = jet.PlanNode
+
+// EXPLAIN creates new EXPLAIN QUERY PLAN statement showing the high-level query plan of the statement. Statement
+// output can be parsed into query plan tree with ExplainStatement QueryPlan method. SQLite query plan does not
+// contain row estimates and costs.
+//
+//	plan, err := EXPLAIN(stmt).QueryPlan(ctx, db)
+func EXPLAIN(statement Statement) ExplainStatement {
+	return jet.NewExplainStatement(Dialect, "EXPLAIN QUERY PLAN", statement, parseQueryPlan)
+}
+
+func parseQueryPlan(rows *sql.Rows) ([]PlanNode, error) {
+	type planRow struct {
+		id   int64
+		node PlanNode
+	}
+
+	var planRows []planRow
+	childrenIDs := map[int64][]int{}
+
+	for rows.Next() {
+		var id, parent, notUsed int64
+		var detail string
+
+		if err := rows.Scan(&id, &parent, &notUsed, &detail); err != nil {
+			return nil, err
+		}
+
+		childrenIDs[parent] = append(childrenIDs[parent], len(planRows))
+		planRows = append(planRows, planRow{id: id, node: planNode(detail)})
+	}
+
+	var nodes func(parent int64) []PlanNode
+
+	nodes = func(parent int64) []PlanNode {
+		var ret []PlanNode
+
+		for _, index := range childrenIDs[parent] {
+			node := planRows[index].node
+			node.Children = nodes(planRows[index].id)
+			ret = append(ret, node)
+		}
+
+		return ret
+	}
+
+	return nodes(0), nil
+}
+
+// planNode parses query plan detail, for instance:
+// SEARCH film USING INDEX idx_title (title=?)
+func planNode(detail string) PlanNode {
+	node := PlanNode{
+		NodeType: detail,
+		Detail:   detail,
+	}
+
+	fields := strings.Fields(detail)
+
+	if len(fields) < 2 || (fields[0] != "SCAN" && fields[0] != "SEARCH") {
+		return node
+	}
+
+	node.NodeType = fields[0]
+	fields = fields[1:]
+
+	if fields[0] == "TABLE" && len(fields) > 1 { // SQLite versions prior to 3.36
+		fields = fields[1:]
+	}
+
+	node.Relation = fields[0]
+
+	usingIndex := strings.Index(detail, " USING ")
+
+	if usingIndex < 0 {
+		return node
+	}
+
+	using := detail[usingIndex+len(" USING "):]
+
+	for _, indexPrefix := range []string{"COVERING INDEX ", "INDEX "} {
+		if !strings.HasPrefix(using, indexPrefix) {
+			continue
+		}
+
+		if indexFields := strings.Fields(using[len(indexPrefix):]); len(indexFields) > 0 {
+			node.Index = indexFields[0]
+		}
+
+		return node
+	}
+
+	if strings.HasPrefix(using, "INTEGER PRIMARY KEY") {
+		node.Index = "INTEGER PRIMARY KEY"
+	}
+
+	return node
+}
@@ -0,0 +1,72 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplain(t *testing.T) {
+	assertStatementSql(t, EXPLAIN(SELECT(table1ColInt).FROM(table1).WHERE(table1ColInt.EQ(Int(1)))), `
+EXPLAIN QUERY PLAN
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int = ?;
+`, int64(1))
+}
+
+func TestExplainQueryPlan(t *testing.T) {
+	ctx := context.Background()
+	db := openQueryTestDB(t)
+
+	_, err := RawStatement(`CREATE INDEX idx_query_test_name ON query_test (name);`).Exec(db)
+	require.NoError(t, err)
+
+	plan, err := EXPLAIN(
+		SELECT(queryTestColID).
+			FROM(queryTestTable).
+			WHERE(queryTestColName.EQ(String("one"))),
+	).QueryPlan(ctx, db)
+	require.NoError(t, err)
+
+	require.Len(t, plan.Nodes, 1)
+	require.Equal(t, "SEARCH", plan.Nodes[0].NodeType)
+	require.Equal(t, "query_test", plan.Nodes[0].Relation)
+	require.True(t, plan.UsesIndex("idx_query_test_name"))
+
+	plan, err = EXPLAIN(
+		SELECT(queryTestColID).
+			FROM(queryTestTable).
+			WHERE(queryTestColScore.GT(Float(1))).
+			ORDER_BY(queryTestColScore),
+	).QueryPlan(ctx, db)
+	require.NoError(t, err)
+
+	require.False(t, plan.UsesIndex("idx_query_test_name"))
+	require.Len(t, plan.Find(func(node PlanNode) bool {
+		return node.NodeType == "SCAN" && node.Relation == "query_test"
+	}), 1)
+	require.Len(t, plan.Find(func(node PlanNode) bool {
+		return node.NodeType == "USE TEMP B-TREE FOR ORDER BY"
+	}), 1)
+}
+
+func TestExplainPlanNode(t *testing.T) {
+	require.Equal(t, PlanNode{
+		NodeType: "SEARCH",
+		Relation: "film",
+		Index:    "idx_title",
+		Detail:   "SEARCH film USING COVERING INDEX idx_title (title>?)",
+	}, planNode("SEARCH film USING COVERING INDEX idx_title (title>?)"))
+	require.Equal(t, PlanNode{
+		NodeType: "SEARCH",
+		Relation: "film",
+		Index:    "INTEGER PRIMARY KEY",
+		Detail:   "SEARCH TABLE film USING INTEGER PRIMARY KEY (rowid=?)",
+	}, planNode("SEARCH TABLE film USING INTEGER PRIMARY KEY (rowid=?)"))
+	require.Equal(t, PlanNode{
+		NodeType: "COMPOUND QUERY",
+		Detail:   "COMPOUND QUERY",
+	}, planNode("COMPOUND QUERY"))
+}
@@ -1,6 +1,17 @@
 package sqlite
 
-import "github.com/go-jet/jet/v2/internal/jet"
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// BeforeInsertHook can be implemented by model types to modify model before it is used by INSERT statement MODEL
+// and MODELS methods. Hook is called on statement execution, on a copy of the model taken by MODEL or MODELS,
+// and error returned from BeforeInsert is returned from the execution. Sql and DebugSql do not call the hook.
+type BeforeInsertHook = jet.BeforeInsertHook
 
 // InsertStatement is interface for SQL INSERT statements
 type InsertStatement interface {
@@ -13,7 +24,23 @@ type InsertStatement interface {
 	DEFAULT_VALUES() InsertStatement
 
 	ON_CONFLICT(indexExpressions ...jet.ColumnExpression) onConflict
+	// ON_CONFLICT_PRIMARY_KEY uses table primary key columns as conflict target. If table does not have
+	// primary key columns, this method will panic.
+	ON_CONFLICT_PRIMARY_KEY() onConflict
 	RETURNING(projections ...Projection) InsertStatement
+
+	// ExecInBatches executes statement over db connection/transaction as a sequence of statements, each containing
+	// a subset of rows, so that no statement exceeds SQLite limit on the number of parametrized arguments.
+	// Returned result contains the total number of rows affected.
+	// Batches are executed as separate statements, so if db is not a transaction, rows inserted by the batches
+	// executed before a failing batch are not rolled back.
+	ExecInBatches(ctx context.Context, db qrm.Executable) (sql.Result, error)
+	// QueryInBatches executes statement over db connection/transaction as a sequence of statements, each containing
+	// a subset of rows, and appends RETURNING rows of each statement to the destination. Destination has to be
+	// a pointer to a slice.
+	// Batches are executed as separate statements, so if db is not a transaction, rows inserted by the batches
+	// executed before a failing batch are not rolled back.
+	QueryInBatches(ctx context.Context, db qrm.Queryable, destination interface{}) error
 }
 
 func newInsertStatement(table Table, columns []jet.Column) InsertStatement {
@@ -54,12 +81,12 @@ func (is *insertStatementImpl) VALUES(value interface{}, values ...interface{})
 // MODEL will insert row of values, where value for each column is extracted from filed of structure data.
 // If data is not struct or there is no field for every column selected, this method will panic.
 func (is *insertStatementImpl) MODEL(data interface{}) InsertStatement {
-	is.ValuesQuery.Rows = append(is.ValuesQuery.Rows, jet.UnwindRowFromModel(is.Insert.GetColumns(), data))
+	is.ValuesQuery.Rows = append(is.ValuesQuery.Rows, jet.UnwindRowFromModel(jet.InsertStatementType, is.Insert.GetColumns(), data))
 	return is
 }
 
 func (is *insertStatementImpl) MODELS(data interface{}) InsertStatement {
-	is.ValuesQuery.Rows = append(is.ValuesQuery.Rows, jet.UnwindRowsFromModels(is.Insert.GetColumns(), data)...)
+	is.ValuesQuery.Rows = append(is.ValuesQuery.Rows, jet.UnwindRowsFromModels(jet.InsertStatementType, is.Insert.GetColumns(), data)...)
 	return is
 }
 
@@ -81,7 +108,41 @@ func (is *insertStatementImpl) RETURNING(projections ...jet.Projection) InsertSt
 func (is *insertStatementImpl) ON_CONFLICT(indexExpressions ...jet.ColumnExpression) onConflict {
 	is.OnConflict = onConflictClause{
 		insertStatement:  is,
+		insertColumns:    is.Insert.GetColumns(),
 		indexExpressions: indexExpressions,
 	}
 	return &is.OnConflict
 }
+
+func (is *insertStatementImpl) ON_CONFLICT_PRIMARY_KEY() onConflict {
+	primaryKeys := jet.PrimaryKeys(is.Insert.Table)
+
+	if len(primaryKeys) == 0 {
+		panic("jet: ON_CONFLICT_PRIMARY_KEY requires table with primary key columns")
+	}
+
+	return is.ON_CONFLICT(primaryKeys...)
+}
+
+// Default SQLITE_MAX_VARIABLE_NUMBER for SQLite versions since 3.32.0.
+const maxArgumentsPerStatement = 32766
+
+func (is *insertStatementImpl) ExecInBatches(ctx context.Context, db qrm.Executable) (sql.Result, error) {
+	return jet.ExecInBatches(ctx, db, Dialect, maxArgumentsPerStatement, is.ValuesQuery.Rows, is.batchStatement)
+}
+
+func (is *insertStatementImpl) QueryInBatches(ctx context.Context, db qrm.Queryable, destination interface{}) error {
+	return jet.QueryInBatches(ctx, db, Dialect, maxArgumentsPerStatement, is.ValuesQuery.Rows, is.batchStatement, destination)
+}
+
+func (is *insertStatementImpl) batchStatement(rows [][]jet.Serializer) Statement {
+	batch := newInsertStatement(nil, nil).(*insertStatementImpl)
+	batch.Insert = is.Insert
+	batch.ValuesQuery = is.ValuesQuery
+	batch.DefaultValues = is.DefaultValues
+	batch.OnConflict = is.OnConflict
+	batch.Returning = is.Returning
+	batch.ValuesQuery.Rows = rows
+
+	return batch
+}
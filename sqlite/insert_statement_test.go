@@ -1,6 +1,8 @@
 package sqlite
 
 import (
+	"context"
+	"github.com/go-jet/jet/v2/qrm/qrmtest"
 	"github.com/stretchr/testify/require"
 	"testing"
 	"time"
@@ -148,3 +150,93 @@ RETURNING table1.col1 AS "table1.col1",
           table1.col_bool AS "table1.col_bool";
 `)
 }
+
+func TestInsert_ON_CONFLICT_PRIMARY_KEY(t *testing.T) {
+	stmt := table1WithPK.INSERT(table1Col1, table1ColInt, table1ColFloat).
+		VALUES(1, 2, 3.3).
+		ON_CONFLICT_PRIMARY_KEY().DO_UPDATE_ALL()
+
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_int, col_float)
+VALUES (?, ?, ?)
+ON CONFLICT (col1) DO UPDATE
+       SET col_int = excluded.col_int,
+           col_float = excluded.col_float;
+`, 1, 2, 3.3)
+
+	require.PanicsWithValue(t, "jet: ON_CONFLICT_PRIMARY_KEY requires table with primary key columns", func() {
+		table1.INSERT(table1ColInt).VALUES(1).ON_CONFLICT_PRIMARY_KEY()
+	})
+}
+
+type batchModel struct {
+	Col1     *int
+	ColFloat *float64
+	ColBool  bool
+}
+
+func batchModels(count int) []batchModel {
+	var models []batchModel
+
+	for i := 0; i < count; i++ {
+		models = append(models, batchModel{ColBool: i%2 == 0})
+	}
+
+	return models
+}
+
+func TestInsert_ExecInBatches(t *testing.T) {
+	stmt := table1.INSERT(table1Col1, table1ColFloat, table1ColBool).
+		MODELS(batchModels(20000)).
+		ON_CONFLICT(table1Col1).DO_NOTHING()
+
+	db := &execRecorder{}
+
+	res, err := stmt.ExecInBatches(context.Background(), db)
+	require.NoError(t, err)
+
+	rowsAffected, err := res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(20000), rowsAffected)
+
+	require.Len(t, db.queries, 2)
+	require.Len(t, db.args[0], 32766)
+	require.Len(t, db.args[1], 60000-32766)
+	require.Contains(t, db.queries[0], "ON CONFLICT (col1) DO NOTHING;")
+	require.Contains(t, db.queries[1], "ON CONFLICT (col1) DO NOTHING;")
+}
+
+func TestInsert_QueryInBatches(t *testing.T) {
+	models := batchModels(12000)
+
+	newStatement := func(models []batchModel) InsertStatement {
+		return table1.INSERT(table1Col1, table1ColFloat, table1ColBool).
+			MODELS(models).
+			RETURNING(table1ColBool)
+	}
+
+	db := qrmtest.New()
+	db.ExpectStatement(newStatement(models[:10922])).
+		WillReturnRows(qrmtest.NewRows("table1.col_bool").AddRow(true).AddRow(false))
+	db.ExpectStatement(newStatement(models[10922:])).
+		WillReturnRows(qrmtest.NewRows("table1.col_bool").AddRow(true))
+
+	var dest []struct {
+		ColBool bool `alias:"table1.col_bool"`
+	}
+
+	err := newStatement(models).QueryInBatches(context.Background(), db, &dest)
+	require.NoError(t, err)
+	require.NoError(t, db.ExpectationsWereMet())
+	require.Len(t, dest, 3)
+	require.True(t, dest[2].ColBool)
+
+	var structDest struct {
+		ColBool bool `alias:"table1.col_bool"`
+	}
+
+	err = newStatement(models).QueryInBatches(context.Background(), db, &structDest)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "jet: batch query destination has to be a pointer to a slice")
+	require.Len(t, db.Calls(), 2)
+}
@@ -12,10 +12,14 @@ type onConflict interface {
 type conflictTarget interface {
 	DO_NOTHING() InsertStatement
 	DO_UPDATE(action conflictAction) InsertStatement
+	// DO_UPDATE_ALL updates each inserted column, except conflict target columns, with the value of the
+	// row proposed for insertion.
+	DO_UPDATE_ALL() InsertStatement
 }
 
 type onConflictClause struct {
 	insertStatement  InsertStatement
+	insertColumns    []jet.Column
 	indexExpressions []jet.ColumnExpression
 	whereClause      jet.ClauseWhere
 	do               jet.Serializer
@@ -36,6 +40,18 @@ func (o *onConflictClause) DO_UPDATE(action conflictAction) InsertStatement {
 	return o.insertStatement
 }
 
+func (o *onConflictClause) DO_UPDATE_ALL() InsertStatement {
+	var conflictColumns []jet.Column
+
+	for _, indexExpression := range o.indexExpressions {
+		conflictColumns = append(conflictColumns, indexExpression)
+	}
+
+	return o.DO_UPDATE(SET(jet.NewColumnAssigments(o.insertColumns, conflictColumns, func(column jet.Column) jet.Expression {
+		return jet.NewTableColumn("excluded", column)
+	})...))
+}
+
 func (o *onConflictClause) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
 	if len(o.indexExpressions) == 0 && o.do == nil {
 		return
@@ -1,7 +1,10 @@
 package sqlite
 
 import (
+	"context"
+
 	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
 )
 
 // RowLock is interface for SELECT statement row lock types
@@ -56,6 +59,21 @@ type SelectStatement interface {
 	UNION_ALL(rhs SelectStatement) setStatement
 
 	AsTable(alias string) SelectTable
+
+	// CountStatement returns new statement that counts the number of rows this statement returns,
+	// with ORDER BY, LIMIT, OFFSET and row lock clauses removed.
+	CountStatement() Statement
+	// QueryWithCount executes statement and stores row results in destination. Total number of rows,
+	// without LIMIT and OFFSET applied, is retrieved with CountStatement.
+	// Data and count queries are executed separately, so for a consistent result db should be a transaction.
+	QueryWithCount(ctx context.Context, db qrm.Queryable, destination interface{}) (totalCount int64, err error)
+	// JSONNestedStatement returns new statement, where projections mapped into destination fields of slice or map
+	// of structs type are aggregated into JSON arrays with correlated sub-queries, instead of being joined into the
+	// result set. Tables of the nested projections are moved from the FROM clause into the sub-queries, and ON condition
+	// of the first moved table becomes sub-query WHERE condition, so rows without nested rows are not filtered out.
+	// LIMIT and OFFSET of the new statement apply to the destination rows only. Statement has to be queried into
+	// destination of the same type.
+	JSONNestedStatement(destination interface{}) Statement
 }
 
 // SELECT creates new SelectStatement with list of projections
@@ -63,6 +81,20 @@ func SELECT(projection Projection, projections ...Projection) SelectStatement {
 	return newSelectStatement(nil, append([]Projection{projection}, projections...))
 }
 
+// SELECT_JSON_ARR creates new SelectStatement that aggregates all the selected rows into a single JSON array of
+// objects. Object keys are projection aliases, so the statement can be used as a nested projection (for instance
+// SELECT_JSON_ARR(Actor.AllColumns).FROM(Actor).WHERE(...).AS("actors")), and query result mapping will decode
+// the JSON array into destination slice field using the same alias conventions as for the result set columns.
+func SELECT_JSON_ARR(projection Projection, projections ...Projection) SelectStatement {
+	return newSelectJSONArrStatement(append([]Projection{projection}, projections...))
+}
+
+var jsonAggregation = jet.JSONAggregation{
+	ObjectFunc:  "json_object",
+	ArrayAgg:    "json_group_array(json(%s))",
+	NestedValue: "json(%s)",
+}
+
 func newSelectStatement(table ReadableTable, projections []Projection) SelectStatement {
 	newSelect := &selectStatementImpl{}
 	newSelect.ExpressionStatement = jet.NewExpressionStatementImpl(Dialect, jet.SelectStatementType, newSelect, &newSelect.Select,
@@ -83,6 +115,18 @@ func newSelectStatement(table ReadableTable, projections []Projection) SelectSta
 	return newSelect
 }
 
+func newSelectJSONArrStatement(projections []Projection) SelectStatement {
+	newSelect := newSelectStatement(nil, nil).(*selectStatementImpl)
+	newSelect.Select.ProjectionList = []Projection{jet.NewJSONArrayAggProjection(jsonAggregation, projections, &newSelect.OrderBy)}
+	newSelect.ExpressionStatement = jet.NewExpressionStatementImpl(Dialect, jet.SelectStatementType, newSelect,
+		&jet.ClauseJSONArrayAgg{Aggregation: jsonAggregation, OrderBy: &newSelect.OrderBy}, &newSelect.Select,
+		&newSelect.From, &newSelect.Where, &newSelect.GroupBy, &newSelect.Having, &newSelect.Window, &newSelect.OrderBy,
+		&newSelect.Limit, &newSelect.Offset, &newSelect.For, &newSelect.ShareLock,
+		&jet.ClauseJSONArrayAgg{Aggregation: jsonAggregation, End: true})
+
+	return newSelect
+}
+
 type selectStatementImpl struct {
 	jet.ExpressionStatement
 	setOperatorsImpl
@@ -146,7 +190,7 @@ func (s *selectStatementImpl) OFFSET(offset int64) SelectStatement {
 }
 
 func (s *selectStatementImpl) FOR(lock RowLock) SelectStatement {
-	s.For.Lock = lock
+	s.For.Locks = []RowLock{lock}
 	return s
 }
 
@@ -159,6 +203,26 @@ func (s *selectStatementImpl) AsTable(alias string) SelectTable {
 	return newSelectTable(s, alias)
 }
 
+func (s *selectStatementImpl) CountStatement() Statement {
+	return jet.NewCountStatement(Dialect, &s.Select, &s.From, &s.Where, &s.GroupBy, &s.Having, &s.Window)
+}
+
+func (s *selectStatementImpl) QueryWithCount(ctx context.Context, db qrm.Queryable, destination interface{}) (totalCount int64, err error) {
+	return jet.QueryWithCount(ctx, db, s, s.CountStatement(), destination)
+}
+
+func (s *selectStatementImpl) JSONNestedStatement(destination interface{}) Statement {
+	nested := newSelectStatement(nil, nil).(*selectStatementImpl)
+	nested.Select, nested.From, nested.Where, nested.GroupBy = s.Select, s.From, s.Where, s.GroupBy
+	nested.Having, nested.Window, nested.OrderBy = s.Having, s.Window, s.OrderBy
+	nested.Limit, nested.Offset, nested.For, nested.ShareLock = s.Limit, s.Offset, s.For, s.ShareLock
+
+	jet.NestJSONProjections(Dialect, jsonAggregation, destination, &nested.Select, &nested.From, &nested.OrderBy,
+		&nested.Where, &nested.GroupBy, &nested.Having, &nested.Window)
+
+	return nested
+}
+
 //-----------------------------------------------------
 
 type windowExpand struct {
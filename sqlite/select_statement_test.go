@@ -1,8 +1,12 @@
 package sqlite
 
 import (
-	"github.com/go-jet/jet/v2/internal/testutils"
+	"context"
 	"testing"
+
+	"github.com/go-jet/jet/v2/internal/testutils"
+	"github.com/go-jet/jet/v2/qrm/qrmtest"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInvalidSelect(t *testing.T) {
@@ -154,3 +158,93 @@ WHERE NOT (EXISTS (
       ));
 `)
 }
+
+func TestSelectCountStatement(t *testing.T) {
+	stmt := SELECT(table1ColInt).
+		FROM(table1).
+		WHERE(table1ColBool.IS_TRUE()).
+		GROUP_BY(table1ColInt).
+		ORDER_BY(table1ColInt.DESC()).
+		LIMIT(10).
+		OFFSET(20)
+
+	assertStatementSql(t, stmt.CountStatement(), `
+SELECT COUNT(*) AS "count"
+FROM (
+          SELECT table1.col_int AS "table1.col_int"
+          FROM db.table1
+          WHERE table1.col_bool IS TRUE
+          GROUP BY table1.col_int
+     ) AS count_subquery;
+`)
+}
+
+func TestSelectJSONArr(t *testing.T) {
+	assertStatementSql(t, SELECT(
+		table1ColInt,
+		SELECT_JSON_ARR(table2ColInt, table2ColFloat.ADD(Float(1.5)).AS("float_plus")).
+			FROM(table2).
+			WHERE(table2ColInt.EQ(table1ColInt)).
+			AS("table2s"),
+	).FROM(table1), `
+SELECT table1.col_int AS "table1.col_int",
+     (
+          SELECT json_object('__jet_rows', json_group_array(json(records.j)))
+          FROM (
+               SELECT json_object(
+                         'table2.col_int', table2.col_int,
+                         'float_plus', (table2.col_float + ?)
+                    ) AS "j"
+               FROM db.table2
+               WHERE table2.col_int = table1.col_int
+          ) AS records
+     ) AS "table2s"
+FROM db.table1;
+`, 1.5)
+
+	assertStatementSql(t, SELECT_JSON_ARR(
+		table1ColInt,
+		SELECT_JSON_ARR(table2ColInt).FROM(table2).WHERE(table2ColInt.EQ(table1ColInt)).AS("table2s"),
+	).FROM(table1), `
+SELECT json_object('__jet_rows', json_group_array(json(records.j)))
+FROM (
+     SELECT json_object(
+               'table1.col_int', table1.col_int,
+               'table2s', json((
+                    SELECT json_object('__jet_rows', json_group_array(json(records.j)))
+                    FROM (
+                         SELECT json_object(
+                                   'table2.col_int', table2.col_int
+                              ) AS "j"
+                         FROM db.table2
+                         WHERE table2.col_int = table1.col_int
+                    ) AS records
+               ))
+          ) AS "j"
+     FROM db.table1
+) AS records;
+`)
+}
+
+func TestSelectQueryWithCount(t *testing.T) {
+	stmt := SELECT(table1ColInt).
+		FROM(table1).
+		ORDER_BY(table1ColInt).
+		LIMIT(2)
+
+	db := qrmtest.New()
+	db.ExpectStatement(stmt).WillReturnRows(qrmtest.NewRows("table1.col_int").AddRow(1).AddRow(2))
+	db.ExpectStatement(stmt.CountStatement()).WillReturnRows(qrmtest.NewRows("count").AddRow(11))
+
+	var dest []struct {
+		ColInt int64 `alias:"table1.col_int"`
+	}
+
+	totalCount, err := stmt.QueryWithCount(context.Background(), db, &dest)
+
+	require.NoError(t, err)
+	require.NoError(t, db.ExpectationsWereMet())
+	require.Len(t, dest, 2)
+	require.Equal(t, int64(2), dest[1].ColInt)
+	require.Equal(t, int64(11), totalCount)
+}
@@ -0,0 +1,339 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	queryTestColID    = IntegerColumn("id")
+	queryTestColName  = StringColumn("name")
+	queryTestColScore = FloatColumn("score")
+	queryTestTable    = NewTableWithPrimaryKey("", "query_test", "", ColumnList{queryTestColID},
+		queryTestColID, queryTestColName, queryTestColScore)
+)
+
+type queryTest struct {
+	ID    int32 `sql:"primary_key"`
+	Name  string
+	Score *float64
+}
+
+func openQueryTestDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "query_test.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	_, err = RawStatement(`
+		CREATE TABLE query_test (id INTEGER PRIMARY KEY, name TEXT NOT NULL, score REAL);
+		INSERT INTO query_test VALUES (1, 'one', 1.5), (2, 'two', NULL), (3, 'three', 3.5);`).Exec(db)
+	require.NoError(t, err)
+
+	return db
+}
+
+func TestQueryAll(t *testing.T) {
+	ctx := context.Background()
+	db := openQueryTestDB(t)
+
+	stmt := SELECT(queryTestColID, queryTestColName, queryTestColScore).
+		FROM(queryTestTable).
+		ORDER_BY(queryTestColID)
+
+	t.Run("structs", func(t *testing.T) {
+		dest, err := QueryAll[queryTest](ctx, stmt, db)
+		require.NoError(t, err)
+		require.Len(t, dest, 3)
+		require.Equal(t, "one", dest[0].Name)
+		require.Nil(t, dest[1].Score)
+	})
+
+	t.Run("pointers to structs", func(t *testing.T) {
+		dest, err := QueryAll[*queryTest](ctx, stmt, db)
+		require.NoError(t, err)
+		require.Len(t, dest, 3)
+		require.Equal(t, int32(3), dest[2].ID)
+	})
+
+	t.Run("simple type", func(t *testing.T) {
+		dest, err := QueryAll[string](ctx, SELECT(queryTestColName).FROM(queryTestTable).ORDER_BY(queryTestColID), db)
+		require.NoError(t, err)
+		require.Equal(t, []string{"one", "two", "three"}, dest)
+	})
+
+	t.Run("empty result", func(t *testing.T) {
+		dest, err := QueryAll[queryTest](ctx, stmt.WHERE(queryTestColID.GT(Int(3))), db)
+		require.NoError(t, err)
+		require.Empty(t, dest)
+	})
+}
+
+func TestQueryOne(t *testing.T) {
+	ctx := context.Background()
+	db := openQueryTestDB(t)
+
+	stmt := SELECT(queryTestColID, queryTestColName, queryTestColScore).
+		FROM(queryTestTable).
+		ORDER_BY(queryTestColID.DESC())
+
+	dest, err := QueryOne[queryTest](ctx, stmt, db)
+	require.NoError(t, err)
+	require.Equal(t, int32(3), dest.ID)
+	require.Equal(t, 3.5, *dest.Score)
+
+	_, err = QueryOne[*queryTest](ctx, stmt.WHERE(queryTestColID.GT(Int(3))), db)
+	require.ErrorIs(t, err, qrm.ErrNoRows)
+}
+
+func TestQueryScalar(t *testing.T) {
+	ctx := context.Background()
+	db := openQueryTestDB(t)
+
+	count, err := QueryScalar[int64](ctx, SELECT(COUNT(STAR)).FROM(queryTestTable), db)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+
+	name, err := QueryScalar[string](ctx, SELECT(queryTestColName).FROM(queryTestTable).WHERE(queryTestColID.EQ(Int(2))), db)
+	require.NoError(t, err)
+	require.Equal(t, "two", name)
+
+	score, err := QueryScalar[*float64](ctx, SELECT(queryTestColScore).FROM(queryTestTable).WHERE(queryTestColID.EQ(Int(2))), db)
+	require.NoError(t, err)
+	require.Nil(t, score)
+
+	_, err = QueryScalar[string](ctx, SELECT(queryTestColName).FROM(queryTestTable).WHERE(queryTestColID.EQ(Int(4))), db)
+	require.ErrorIs(t, err, qrm.ErrNoRows)
+
+	_, err = QueryScalar[int64](ctx, SELECT(queryTestColID, queryTestColName).FROM(queryTestTable), db)
+	require.EqualError(t, err, "jet: scalar query has to return single column, got 2")
+}
+
+var (
+	jsonFilmColID      = IntegerColumn("id")
+	jsonFilmColTitle   = StringColumn("title")
+	jsonFilmTable      = NewTableWithPrimaryKey("", "film", "", ColumnList{jsonFilmColID}, jsonFilmColID, jsonFilmColTitle)
+	jsonActorColID     = IntegerColumn("id")
+	jsonActorColFilmID = IntegerColumn("film_id")
+	jsonActorColName   = StringColumn("name")
+	jsonActorColBorn   = DateTimeColumn("born")
+	jsonActorTable     = NewTableWithPrimaryKey("", "actor", "", ColumnList{jsonActorColID},
+		jsonActorColID, jsonActorColFilmID, jsonActorColName, jsonActorColBorn)
+)
+
+func TestSelectJSONArrQuery(t *testing.T) {
+	type Film struct {
+		ID    int32 `sql:"primary_key"`
+		Title string
+	}
+
+	type Actor struct {
+		ID     int64 `sql:"primary_key"`
+		FilmID int32
+		Name   string
+		Born   *time.Time
+	}
+
+	db := openQueryTestDB(t)
+
+	_, err := RawStatement(`
+		CREATE TABLE film (id INTEGER PRIMARY KEY, title TEXT NOT NULL);
+		CREATE TABLE actor (id INTEGER PRIMARY KEY, film_id INTEGER NOT NULL, name TEXT NOT NULL, born TEXT);
+		INSERT INTO film VALUES (1, 'Alien'), (2, 'Brazil'), (3, 'Casablanca');
+		INSERT INTO actor VALUES (1, 1, 'Sigourney', '1949-10-08 00:00:00'), (2, 1, 'Tom', NULL), (3, 2, 'Jonathan', NULL);`).Exec(db)
+	require.NoError(t, err)
+
+	actors := SELECT_JSON_ARR(ColumnList{jsonActorColID, jsonActorColFilmID, jsonActorColName, jsonActorColBorn}).
+		FROM(jsonActorTable).
+		WHERE(jsonActorColFilmID.EQ(jsonFilmColID)).
+		ORDER_BY(jsonActorColID.DESC())
+
+	stmt := SELECT(
+		jsonFilmColID,
+		jsonFilmColTitle,
+		actors.AS("actors"),
+	).FROM(
+		jsonFilmTable,
+	).ORDER_BY(
+		jsonFilmColID,
+	)
+
+	var dest []struct {
+		Film
+		Actors []Actor
+	}
+
+	err = stmt.Query(db, &dest)
+	require.NoError(t, err)
+	require.Len(t, dest, 3)
+	require.Equal(t, "Alien", dest[0].Title)
+	require.Equal(t, []Actor{
+		{ID: 2, FilmID: 1, Name: "Tom"},
+		{ID: 1, FilmID: 1, Name: "Sigourney", Born: ptrTime(time.Date(1949, 10, 8, 0, 0, 0, 0, time.UTC))},
+	}, dest[0].Actors)
+	require.Equal(t, "Jonathan", dest[1].Actors[0].Name)
+	require.Empty(t, dest[2].Actors)
+
+	t.Run("nested", func(t *testing.T) {
+		stmt := SELECT_JSON_ARR(
+			jsonFilmColID,
+			jsonFilmColTitle,
+			SELECT_JSON_ARR(
+				jsonActorColID,
+				jsonActorColName,
+				SELECT_JSON_ARR(jsonFilmColID, jsonFilmColTitle).
+					FROM(jsonFilmTable).
+					WHERE(jsonFilmColID.EQ(jsonActorColFilmID)).AS("films"),
+			).FROM(jsonActorTable).WHERE(jsonActorColFilmID.EQ(jsonFilmColID)).ORDER_BY(jsonActorColID).AS("actors"),
+		).FROM(jsonFilmTable).WHERE(jsonFilmColID.EQ(Int(1)))
+
+		var result struct {
+			Films []struct {
+				Film
+				Actors []struct {
+					Actor
+					Films []Film
+				}
+			}
+		}
+
+		err := SELECT(stmt.AS("films")).Query(db, &result)
+		require.NoError(t, err)
+		require.Len(t, result.Films, 1)
+		require.Len(t, result.Films[0].Actors, 2)
+		require.Equal(t, "Sigourney", result.Films[0].Actors[0].Name)
+		require.Equal(t, []Film{{ID: 1, Title: "Alien"}}, result.Films[0].Actors[1].Films)
+	})
+
+	t.Run("nested statement", func(t *testing.T) {
+		stmt := SELECT(
+			jsonFilmColID,
+			jsonFilmColTitle,
+			ColumnList{jsonActorColID, jsonActorColFilmID, jsonActorColName, jsonActorColBorn},
+		).FROM(
+			jsonFilmTable.
+				LEFT_JOIN(jsonActorTable, jsonActorColFilmID.EQ(jsonFilmColID)),
+		).WHERE(
+			jsonFilmColID.LT_EQ(Int(2)),
+		).ORDER_BY(
+			jsonFilmColID.DESC(),
+			jsonActorColID,
+		).LIMIT(1)
+
+		var dest []struct {
+			Film
+			Actors []Actor
+		}
+
+		err := stmt.JSONNestedStatement(&dest).Query(db, &dest)
+		require.NoError(t, err)
+		require.Len(t, dest, 1)
+		require.Equal(t, "Brazil", dest[0].Title)
+		require.Equal(t, []Actor{{ID: 3, FilmID: 2, Name: "Jonathan"}}, dest[0].Actors)
+
+		var joinDest []struct {
+			Film
+			Actors []Actor
+		}
+
+		err = stmt.LIMIT(-1).Query(db, &joinDest)
+		require.NoError(t, err)
+
+		dest = nil
+		err = stmt.LIMIT(-1).JSONNestedStatement(&dest).Query(db, &dest)
+		require.NoError(t, err)
+		require.Equal(t, joinDest, dest)
+	})
+}
+
+func ptrTime(t time.Time) *time.Time {
+	return &t
+}
+
+type HookedModel struct {
+	ID    int32 `sql:"primary_key"`
+	Name  string
+	Score *float64
+}
+
+func (h *HookedModel) BeforeInsert() error {
+	if strings.TrimSpace(h.Name) == "" {
+		return errors.New("name is required")
+	}
+
+	h.Name = strings.TrimSpace(h.Name)
+	return nil
+}
+
+func (h *HookedModel) BeforeUpdate() error {
+	h.Name = strings.ToUpper(h.Name)
+	return nil
+}
+
+func (h *HookedModel) AfterScan() error {
+	if h.Score == nil {
+		h.Score = new(float64)
+	}
+	return nil
+}
+
+func TestModelHooks(t *testing.T) {
+	db := openQueryTestDB(t)
+	columns := ColumnList{queryTestColID, queryTestColName, queryTestColScore}
+
+	model := HookedModel{ID: 4, Name: "  four "}
+	_, err := queryTestTable.INSERT(columns).MODEL(&model).Exec(db)
+	require.NoError(t, err)
+	require.Equal(t, "  four ", model.Name)
+
+	valueModel := HookedModel{ID: 5, Name: " five"}
+	_, err = queryTestTable.INSERT(columns).MODELS([]HookedModel{valueModel, {ID: 6, Name: "six "}}).Exec(db)
+	require.NoError(t, err)
+	require.Equal(t, " five", valueModel.Name)
+
+	_, err = queryTestTable.UPDATE(queryTestColName).MODEL(HookedModel{Name: "updated"}).WHERE(queryTestColID.EQ(Int(6))).Exec(db)
+	require.NoError(t, err)
+
+	var dest []struct {
+		HookedModel `alias:"query_test"`
+	}
+
+	err = SELECT(columns).FROM(queryTestTable).WHERE(queryTestColID.GT(Int(3))).ORDER_BY(queryTestColID).Query(db, &dest)
+	require.NoError(t, err)
+	require.Len(t, dest, 3)
+	require.Equal(t, "four", dest[0].Name)
+	require.Equal(t, "five", dest[1].Name)
+	require.Equal(t, "UPDATED", dest[2].Name)
+	require.Equal(t, 0.0, *dest[2].Score)
+
+	t.Run("sql without hooks", func(t *testing.T) {
+		model := HookedModel{ID: 9, Name: " nine "}
+		stmt := queryTestTable.INSERT(columns).MODEL(&model)
+
+		_, args := stmt.Sql()
+		require.Equal(t, []interface{}{int32(9), " nine ", nil}, args)
+		require.Contains(t, stmt.DebugSql(), "' nine '")
+		require.Equal(t, " nine ", model.Name)
+	})
+
+	t.Run("hook error", func(t *testing.T) {
+		stmt := queryTestTable.INSERT(columns).MODELS([]*HookedModel{{ID: 7, Name: "seven"}, {ID: 8}})
+
+		_, err := stmt.Exec(db)
+		require.EqualError(t, err, "jet: BeforeInsert hook of sqlite.HookedModel failed: name is required")
+
+		_, args := stmt.Sql()
+		require.Equal(t, []interface{}{int32(7), "seven", nil, int32(8), "", nil}, args)
+
+		count, err := QueryScalar[int64](context.Background(), SELECT(COUNT(STAR)).FROM(queryTestTable), db)
+		require.NoError(t, err)
+		require.Equal(t, int64(6), count)
+	})
+}
@@ -77,8 +77,13 @@ func (r readableTableInterfaceImpl) CROSS_JOIN(table ReadableTable) joinSelectUp
 
 // NewTable creates new table with schema Name, table Name and list of columns
 func NewTable(schemaName, name, alias string, columns ...jet.ColumnExpression) Table {
+	return NewTableWithPrimaryKey(schemaName, name, alias, nil, columns...)
+}
+
+// NewTableWithPrimaryKey creates new table with schema Name, table Name, list of primary key columns and list of columns
+func NewTableWithPrimaryKey(schemaName, name, alias string, primaryKey ColumnList, columns ...jet.ColumnExpression) Table {
 	t := &tableImpl{
-		SerializerTable: jet.NewTable(schemaName, name, alias, columns...),
+		SerializerTable: jet.NewTableWithPrimaryKey(schemaName, name, alias, primaryKey, columns...),
 	}
 
 	t.readableTableInterfaceImpl.parent = t
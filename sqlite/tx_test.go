@@ -0,0 +1,169 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+var txTestColID = IntegerColumn("id")
+var txTestTable = NewTable("", "tx_test", "", txTestColID)
+
+func openTxTestDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "tx_test.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	_, err = RawStatement("CREATE TABLE tx_test (id INTEGER PRIMARY KEY);").Exec(db)
+	require.NoError(t, err)
+
+	return db
+}
+
+func insertTxTestRow(tx *Tx, id int) error {
+	_, err := txTestTable.INSERT(txTestColID).VALUES(id).Exec(tx)
+	return err
+}
+
+func requireTxTestRows(t *testing.T, db *sql.DB, expectedIDs ...int32) {
+	var dest []struct {
+		ID int32
+	}
+
+	err := SELECT(txTestColID.AS("id")).FROM(txTestTable).ORDER_BY(txTestColID).Query(db, &dest)
+	require.NoError(t, err)
+
+	var ids []int32
+	for _, row := range dest {
+		ids = append(ids, row.ID)
+	}
+
+	require.Equal(t, expectedIDs, ids)
+}
+
+type serializationFailure struct{}
+
+func (s serializationFailure) Error() string    { return "could not serialize access" }
+func (s serializationFailure) SQLState() string { return "40001" }
+
+func TestWithTx(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("commit", func(t *testing.T) {
+		db := openTxTestDB(t)
+
+		err := WithTx(ctx, db, nil, func(tx *Tx) error {
+			return insertTxTestRow(tx, 1)
+		})
+
+		require.NoError(t, err)
+		requireTxTestRows(t, db, 1)
+	})
+
+	t.Run("rollback", func(t *testing.T) {
+		db := openTxTestDB(t)
+		expectedErr := errors.New("failed")
+
+		err := WithTx(ctx, db, nil, func(tx *Tx) error {
+			require.NoError(t, insertTxTestRow(tx, 1))
+			return expectedErr
+		})
+
+		require.Equal(t, expectedErr, err)
+		requireTxTestRows(t, db)
+	})
+
+	t.Run("rollback on panic", func(t *testing.T) {
+		db := openTxTestDB(t)
+
+		require.PanicsWithValue(t, "panic", func() {
+			_ = WithTx(ctx, db, nil, func(tx *Tx) error {
+				require.NoError(t, insertTxTestRow(tx, 1))
+				panic("panic")
+			})
+		})
+
+		requireTxTestRows(t, db)
+	})
+
+	t.Run("nested savepoints", func(t *testing.T) {
+		db := openTxTestDB(t)
+
+		err := WithTx(ctx, db, nil, func(tx *Tx) error {
+			require.NoError(t, insertTxTestRow(tx, 1))
+
+			err := WithTx(ctx, tx, nil, func(tx *Tx) error {
+				require.NoError(t, insertTxTestRow(tx, 2))
+				return errors.New("nested failed")
+			})
+			require.EqualError(t, err, "nested failed")
+
+			return WithTx(ctx, tx, nil, func(tx *Tx) error {
+				return WithTx(ctx, tx, nil, func(tx *Tx) error {
+					return insertTxTestRow(tx, 3)
+				})
+			})
+		})
+
+		require.NoError(t, err)
+		requireTxTestRows(t, db, 1, 3)
+	})
+
+	t.Run("retry", func(t *testing.T) {
+		db := openTxTestDB(t)
+		attempts := 0
+
+		err := WithTx(ctx, db, &TxOptions{
+			MaxRetries: 3,
+			Backoff:    func(attempt int) time.Duration { return 0 },
+		}, func(tx *Tx) error {
+			attempts++
+			require.NoError(t, insertTxTestRow(tx, attempts))
+
+			if attempts < 3 {
+				return serializationFailure{}
+			}
+
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, 3, attempts)
+		requireTxTestRows(t, db, 3)
+	})
+
+	t.Run("retries exhausted", func(t *testing.T) {
+		db := openTxTestDB(t)
+		attempts := 0
+
+		err := WithTx(ctx, db, &TxOptions{
+			MaxRetries: 2,
+			Backoff:    func(attempt int) time.Duration { return 0 },
+		}, func(tx *Tx) error {
+			attempts++
+			return serializationFailure{}
+		})
+
+		require.Equal(t, serializationFailure{}, err)
+		require.Equal(t, 3, attempts)
+	})
+
+	t.Run("not retryable", func(t *testing.T) {
+		db := openTxTestDB(t)
+		attempts := 0
+
+		err := WithTx(ctx, db, nil, func(tx *Tx) error {
+			attempts++
+			return errors.New("not retryable")
+		})
+
+		require.EqualError(t, err, "not retryable")
+		require.Equal(t, 1, attempts)
+	})
+}
@@ -1,6 +1,17 @@
 package sqlite
 
-import "github.com/go-jet/jet/v2/internal/jet"
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// BeforeUpdateHook can be implemented by model types to modify model before it is used by UPDATE statement MODEL
+// and MODELS methods. Hook is called on statement execution, on a copy of the model taken by MODEL or MODELS,
+// and error returned from BeforeUpdate is returned from the execution. Sql and DebugSql do not call the hook.
+type BeforeUpdateHook = jet.BeforeUpdateHook
 
 // UpdateStatement is interface of SQL UPDATE statement
 type UpdateStatement interface {
@@ -8,10 +19,19 @@ type UpdateStatement interface {
 
 	SET(value interface{}, values ...interface{}) UpdateStatement
 	MODEL(data interface{}) UpdateStatement
+	// MODELS updates table rows with the values of the list of models. Rows are matched on the table primary key
+	// columns, which are also extracted from the models. If data is not a slice of structures or table does not
+	// have primary key columns, this method will panic.
+	MODELS(data interface{}) UpdateStatement
 
 	FROM(tables ...ReadableTable) UpdateStatement
 	WHERE(expression BoolExpression) UpdateStatement
 	RETURNING(projections ...Projection) UpdateStatement
+
+	// ExecInBatches executes statement over db connection/transaction as a sequence of statements, each containing
+	// a subset of MODELS rows, so that no statement exceeds SQLite limit on the number of parametrized arguments.
+	// Returned result contains the total number of rows affected.
+	ExecInBatches(ctx context.Context, db qrm.Executable) (sql.Result, error)
 }
 
 type updateStatementImpl struct {
@@ -23,6 +43,9 @@ type updateStatementImpl struct {
 	SetNew    jet.SetClauseNew
 	Where     jet.ClauseWhere
 	Returning jet.ClauseReturning
+
+	ModelsWith clauseUpdateModelsWith
+	Models     clauseUpdateModels
 }
 
 func newUpdateStatement(table Table, columns []jet.Column) UpdateStatement {
@@ -58,10 +81,30 @@ func (u *updateStatementImpl) SET(value interface{}, values ...interface{}) Upda
 }
 
 func (u *updateStatementImpl) MODEL(data interface{}) UpdateStatement {
-	u.Set.Values = jet.UnwindRowFromModel(u.Set.Columns, data)
+	u.Set.Values = jet.UnwindRowFromModel(jet.UpdateStatementType, u.Set.Columns, data)
 	return u
 }
 
+func (u *updateStatementImpl) MODELS(data interface{}) UpdateStatement {
+	u.setModels(jet.NewUpdateModels(u.Update.Table, u.Set.Columns, data))
+	return u
+}
+
+func (u *updateStatementImpl) setModels(models *jet.UpdateModels) {
+	u.Models = clauseUpdateModels{
+		UpdateModels: models,
+		From:         &u.From,
+		Where:        &u.Where,
+	}
+	u.ModelsWith.UpdateModels = models
+
+	u.SerializerStatement = jet.NewStatementImpl(Dialect, jet.UpdateStatementType, u,
+		&u.ModelsWith,
+		&u.Update,
+		&u.Models,
+		&u.Returning)
+}
+
 func (u *updateStatementImpl) FROM(tables ...ReadableTable) UpdateStatement {
 	u.From.Tables = readableTablesToSerializerList(tables)
 	return u
@@ -76,3 +119,82 @@ func (u *updateStatementImpl) RETURNING(projections ...Projection) UpdateStateme
 	u.Returning.ProjectionList = projections
 	return u
 }
+
+func (u *updateStatementImpl) ExecInBatches(ctx context.Context, db qrm.Executable) (sql.Result, error) {
+	if u.Models.UpdateModels == nil {
+		return u.ExecContext(ctx, db)
+	}
+
+	return jet.ExecInBatches(ctx, db, Dialect, maxArgumentsPerStatement, u.Models.Rows, u.batchStatement)
+}
+
+func (u *updateStatementImpl) batchStatement(rows [][]jet.Serializer) Statement {
+	batch := newUpdateStatement(nil, nil).(*updateStatementImpl)
+	batch.Update = u.Update
+	batch.From = u.From
+	batch.Where = u.Where
+	batch.Returning = u.Returning
+	batch.setModels(u.Models.WithRows(rows))
+
+	return batch
+}
+
+// clauseUpdateModelsWith serializes common table expression of UPDATE MODELS statement:
+//
+//	WITH v (pk, col) AS (VALUES (?, ?), (?, ?))
+type clauseUpdateModelsWith struct {
+	*jet.UpdateModels
+}
+
+func (m *clauseUpdateModelsWith) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	m.CheckRows(out)
+
+	out.NewLine()
+	out.WriteString("WITH")
+	out.WriteIdentifier(jet.UpdateModelsAlias)
+	out.WriteString("(")
+	jet.SerializeColumnNames(m.ValueColumns(), out)
+	out.WriteString(")")
+	out.WriteString("AS (")
+	out.IncreaseIdent()
+	values := jet.ClauseValues{Rows: m.Rows}
+	values.Serialize(statementType, out, options...)
+	out.DecreaseIdent()
+	out.NewLine()
+	out.WriteString(")")
+}
+
+// clauseUpdateModels serializes SET, FROM and WHERE clauses of UPDATE MODELS statement:
+//
+//	SET col = v.col
+//	FROM v
+//	WHERE table.pk = v.pk
+type clauseUpdateModels struct {
+	*jet.UpdateModels
+	From  *jet.ClauseFrom
+	Where *jet.ClauseWhere
+}
+
+func (m *clauseUpdateModels) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	out.NewLine()
+	out.WriteString("SET")
+	m.SerializeAssignments(out, false)
+
+	out.NewLine()
+	out.WriteString("FROM")
+	out.IncreaseIdent()
+	out.WriteIdentifier(jet.UpdateModelsAlias)
+
+	for _, table := range m.From.Tables {
+		out.WriteString(",")
+		out.NewLine()
+		jet.Serialize(table, statementType, out, jet.FallTrough(options)...)
+	}
+	out.DecreaseIdent()
+
+	out.NewLine()
+	out.WriteString("WHERE")
+	out.IncreaseIdent(6)
+	m.SerializeCondition(statementType, out, m.Where.Condition)
+	out.DecreaseIdent(6)
+}
@@ -1,9 +1,14 @@
 package sqlite
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestUpdateWithOneValue(t *testing.T) {
@@ -80,3 +85,96 @@ func TestInvalidInputs(t *testing.T) {
 	assertStatementSqlErr(t, table1.UPDATE(table1ColInt).SET(1), "jet: WHERE clause not set")
 	assertStatementSqlErr(t, table1.UPDATE(nil).SET(1), "jet: nil column in columns list for SET clause")
 }
+
+var table1WithPK = NewTableWithPrimaryKey("db", "table1", "", ColumnList{table1Col1}, table1Col1, table1ColInt, table1ColFloat)
+
+type updateModel struct {
+	Col1     int
+	ColInt   int
+	ColFloat float64
+}
+
+func TestUpdateModels(t *testing.T) {
+	expectedSQL := `
+WITH v (col1, col_int, col_float) AS (
+     VALUES (?, ?, ?),
+            (?, ?, ?)
+)
+UPDATE db.table1
+SET col_int = v.col_int,
+    col_float = v.col_float
+FROM v
+WHERE table1.col1 = v.col1 AND (table1.col_int < ?)
+RETURNING table1.col1 AS "table1.col1";
+`
+	stmt := table1WithPK.UPDATE(table1ColInt, table1ColFloat).
+		MODELS([]updateModel{
+			{Col1: 1, ColInt: 10, ColFloat: 1.1},
+			{Col1: 2, ColInt: 20, ColFloat: 2.2},
+		}).
+		WHERE(table1ColInt.LT(Int(100))).
+		RETURNING(table1Col1)
+
+	assertStatementSql(t, stmt, expectedSQL, 1, 10, 1.1, 2, 20, 2.2, int64(100))
+}
+
+func TestUpdateModelsInvalidInputs(t *testing.T) {
+	require.PanicsWithValue(t, "jet: UPDATE MODELS requires table with primary key columns", func() {
+		table1.UPDATE(table1ColInt).MODELS([]updateModel{})
+	})
+}
+
+type execRecorder struct {
+	queries []string
+	args    [][]interface{}
+}
+
+func (e *execRecorder) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	e.queries = append(e.queries, query)
+	e.args = append(e.args, args)
+	return driver.RowsAffected(len(args) / 3), nil
+}
+
+func TestUpdateModels_ExecInBatches(t *testing.T) {
+	var models []updateModel
+
+	for i := 0; i < 20000; i++ {
+		models = append(models, updateModel{Col1: i})
+	}
+
+	stmt := table1WithPK.UPDATE(table1ColInt, table1ColFloat).
+		MODELS(models)
+
+	db := &execRecorder{}
+
+	res, err := stmt.ExecInBatches(context.Background(), db)
+	require.NoError(t, err)
+
+	rowsAffected, err := res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(20000), rowsAffected)
+
+	require.Len(t, db.queries, 2)
+	require.Len(t, db.args[0], 32766)
+	require.Len(t, db.args[1], 60000-32766)
+	require.Contains(t, db.queries[1], "WITH v (col1, col_int, col_float) AS (")
+	require.Contains(t, db.queries[1], "WHERE table1.col1 = v.col1;")
+}
+
+func TestUpdateModelsEmpty(t *testing.T) {
+	stmt := table1WithPK.UPDATE(table1ColInt, table1ColFloat).
+		MODELS([]updateModel{})
+
+	db := &execRecorder{}
+
+	_, err := stmt.ExecContext(context.Background(), db)
+	require.EqualError(t, err, "jet: UPDATE MODELS has no rows, models slice is empty")
+
+	res, err := stmt.ExecInBatches(context.Background(), db)
+	require.NoError(t, err)
+
+	rowsAffected, err := res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), rowsAffected)
+	require.Empty(t, db.queries)
+}
@@ -0,0 +1,49 @@
+package sqlite
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+type values struct {
+	rows   []Expression
+	models interface{}
+}
+
+// VALUES creates table source from the list of rows constructed with ROW. Table alias and column names are set
+// with AS method, and table columns can be referenced with column From method. SQLite does not support derived
+// table column list, so VALUES columns (column1, column2, ...) are renamed with a wrapping SELECT statement.
+//
+//	id, code := IntegerColumn("id"), StringColumn("code")
+//	codes := VALUES(
+//		ROW(Int(1), String("a")),
+//		ROW(Int(2), String("b")),
+//	).AS("codes", id, code)
+//
+//	SELECT(Film.AllColumns, code.From(codes)).
+//		FROM(Film.INNER_JOIN(codes, Film.FilmID.EQ(id.From(codes))))
+func VALUES(rows ...Expression) values {
+	return values{rows: rows}
+}
+
+// MODELS adds one row for each struct from data slice. Row values are struct fields matching table columns
+// set with AS method, in the same way as for INSERT statement MODELS. If VALUES has no rows because data slice
+// is empty, statement using VALUES table returns an error on execution, so callers should check the slice length
+// before building the statement.
+func (v values) MODELS(data interface{}) values {
+	v.models = data
+	return v
+}
+
+// AS sets VALUES table alias name and the list of columns
+func (v values) AS(alias string, columns ...jet.ColumnExpression) SelectTable {
+	valuesTable := &selectTableImpl{
+		SelectTable: jet.NewValuesTable(
+			jet.ValuesTableOptions{SelectColumns: true},
+			jet.UnwindValuesRows(v.rows, v.models, columns),
+			alias,
+			columns,
+		),
+	}
+
+	valuesTable.readableTableInterfaceImpl.parent = valuesTable
+
+	return valuesTable
+}
@@ -0,0 +1,72 @@
+package sqlite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValuesTable(t *testing.T) {
+	id, code := IntegerColumn("id"), StringColumn("code")
+
+	codes := VALUES(
+		ROW(Int(1), String("a")),
+		ROW(Int(2), String("b")),
+	).AS("codes", id, code)
+
+	stmt := SELECT(
+		table1ColFloat, code.From(codes),
+	).FROM(
+		table1.INNER_JOIN(codes, table1ColInt.EQ(id.From(codes))),
+	)
+
+	assertStatementSql(t, stmt, `
+SELECT table1.col_float AS "table1.col_float",
+     codes.code AS "code"
+FROM db.table1
+     INNER JOIN (
+          SELECT column1 AS id,
+               column2 AS code
+          FROM (
+               VALUES (?, ?),
+                      (?, ?)
+          )
+     ) AS codes ON (table1.col_int = codes.id);
+`, int64(1), "a", int64(2), "b")
+}
+
+func TestValuesTableQuery(t *testing.T) {
+	db := openQueryTestDB(t)
+
+	type Label struct {
+		ID    int64
+		Label string
+	}
+
+	id, label := IntegerColumn("id"), StringColumn("label")
+
+	labels := VALUES(ROW(Int(1), String("first"))).
+		MODELS([]Label{{ID: 3, Label: "third"}}).
+		AS("labels", id, label)
+
+	stmt := SELECT(
+		queryTestColName.AS("result.name"),
+		label.From(labels).AS("result.label"),
+	).FROM(
+		queryTestTable.INNER_JOIN(labels, queryTestColID.EQ(id.From(labels))),
+	).ORDER_BY(
+		queryTestColID,
+	)
+
+	var dest []struct {
+		Name  string `alias:"result.name"`
+		Label string `alias:"result.label"`
+	}
+
+	require.NoError(t, stmt.Query(db, &dest))
+	require.Len(t, dest, 2)
+	require.Equal(t, "one", dest[0].Name)
+	require.Equal(t, "first", dest[0].Label)
+	require.Equal(t, "three", dest[1].Name)
+	require.Equal(t, "third", dest[1].Label)
+}
@@ -118,3 +118,21 @@ WHERE link.name IN ('Gmail', 'Outlook');
 		require.NoError(t, err)
 	})
 }
+
+func TestDeleteJoinTable(t *testing.T) {
+	stmt := table.Rental.
+		INNER_JOIN(table.Staff, table.Rental.StaffID.EQ(table.Staff.StaffID)).
+		DELETE(table.Rental).
+		WHERE(
+			table.Staff.StaffID.NOT_EQ(Int(2)).
+				AND(table.Rental.RentalID.LT(Int(100))),
+		)
+
+	testutils.AssertStatementSql(t, stmt, `
+DELETE dvds.rental FROM dvds.rental
+INNER JOIN dvds.staff ON (rental.staff_id = staff.staff_id)
+WHERE (staff.staff_id != ?) AND (rental.rental_id < ?);
+`, int64(2), int64(100))
+
+	testutils.AssertExecAndRollback(t, stmt, db)
+}
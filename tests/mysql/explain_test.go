@@ -0,0 +1,50 @@
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-jet/jet/v2/internal/testutils"
+	. "github.com/go-jet/jet/v2/mysql"
+	. "github.com/go-jet/jet/v2/tests/.gentestdata/mysql/dvds/table"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplainQueryPlan(t *testing.T) {
+	query := SELECT(
+		Film.FilmID,
+		Film.Title,
+	).FROM(
+		Film,
+	).WHERE(
+		Film.Title.EQ(String("ACE GOLDFINGER")),
+	)
+
+	stmt := EXPLAIN(query)
+
+	testutils.AssertDebugStatementSql(t, stmt, `
+EXPLAIN FORMAT=JSON
+SELECT film.film_id AS "film.film_id",
+     film.title AS "film.title"
+FROM dvds.film
+WHERE film.title = 'ACE GOLDFINGER';
+`)
+
+	plan, err := stmt.QueryPlan(context.Background(), db)
+	require.NoError(t, err)
+	require.True(t, plan.UsesIndex("idx_title"))
+
+	if sourceIsMariaDB() {
+		return // EXPLAIN ANALYZE is not supported
+	}
+
+	plan, err = EXPLAIN(query, ExplainOptions{Analyze: true}).QueryPlan(context.Background(), db)
+	require.NoError(t, err)
+
+	indexLookups := plan.Find(func(node PlanNode) bool {
+		return node.Index == "idx_title"
+	})
+	require.Len(t, indexLookups, 1)
+	require.Equal(t, "film", indexLookups[0].Relation)
+	require.Equal(t, float64(1), *indexLookups[0].ActualRows)
+}
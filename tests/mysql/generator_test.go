@@ -293,10 +293,11 @@ func newActorTableImpl(schemaName, tableName, alias string) actorTable {
 		LastUpdateColumn = mysql.TimestampColumn("last_update")
 		allColumns       = mysql.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, LastUpdateColumn}
 		mutableColumns   = mysql.ColumnList{FirstNameColumn, LastNameColumn, LastUpdateColumn}
+		primaryKeys      = mysql.ColumnList{ActorIDColumn}
 	)
 
 	return actorTable{
-		Table: mysql.NewTable(schemaName, tableName, alias, allColumns...),
+		Table: mysql.NewTableWithPrimaryKey(schemaName, tableName, alias, primaryKeys, allColumns...),
 
 		//Columns
 		ActorID:    ActorIDColumn,
@@ -434,10 +435,11 @@ func newActorInfoTableImpl(schemaName, tableName, alias string) actorInfoTable {
 		FilmInfoColumn  = mysql.StringColumn("film_info")
 		allColumns      = mysql.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, FilmInfoColumn}
 		mutableColumns  = mysql.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, FilmInfoColumn}
+		primaryKeys     = mysql.ColumnList{}
 	)
 
 	return actorInfoTable{
-		Table: mysql.NewTable(schemaName, tableName, alias, allColumns...),
+		Table: mysql.NewTableWithPrimaryKey(schemaName, tableName, alias, primaryKeys, allColumns...),
 
 		//Columns
 		ActorID:   ActorIDColumn,
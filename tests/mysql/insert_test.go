@@ -390,3 +390,67 @@ VALUES ('http://www.google.com', 'Google', NULL);
 		require.NoError(t, err)
 	})
 }
+
+func TestInsertIgnore(t *testing.T) {
+	randId := rand.Int31()
+
+	stmt := Link.INSERT_IGNORE().
+		VALUES(randId, "http://www.postgresqltutorial.com", "PostgreSQL Tutorial", DEFAULT).
+		VALUES(randId, "http://www.google.com", "Google", DEFAULT)
+
+	testutils.AssertStatementSql(t, stmt, `
+INSERT IGNORE INTO test_sample.link
+VALUES (?, ?, ?, DEFAULT),
+       (?, ?, ?, DEFAULT);
+`, randId, "http://www.postgresqltutorial.com", "PostgreSQL Tutorial",
+		randId, "http://www.google.com", "Google")
+
+	testutils.ExecuteInTxAndRollback(t, db, func(tx *sql.Tx) {
+		res, err := stmt.Exec(tx)
+		require.NoError(t, err)
+		rowsAffected, err := res.RowsAffected()
+		require.NoError(t, err)
+		require.Equal(t, int64(1), rowsAffected) // duplicate row is ignored
+
+		var link model.Link
+
+		err = SELECT(Link.AllColumns).
+			FROM(Link).
+			WHERE(Link.ID.EQ(Int32(randId))).
+			Query(tx, &link)
+
+		require.NoError(t, err)
+		require.Equal(t, "PostgreSQL Tutorial", link.Name)
+	})
+}
+
+func TestReplace(t *testing.T) {
+	randId := rand.Int31()
+
+	stmt := Link.REPLACE(Link.ID, Link.URL, Link.Name).
+		MODEL(model.Link{ID: randId, URL: "http://www.postgresqltutorial.com", Name: "PostgreSQL Tutorial"}).
+		MODELS([]model.Link{{ID: randId, URL: "http://www.google.com", Name: "Google"}})
+
+	testutils.AssertStatementSql(t, stmt, `
+REPLACE INTO test_sample.link (id, url, name)
+VALUES (?, ?, ?),
+       (?, ?, ?);
+`, randId, "http://www.postgresqltutorial.com", "PostgreSQL Tutorial",
+		randId, "http://www.google.com", "Google")
+
+	testutils.ExecuteInTxAndRollback(t, db, func(tx *sql.Tx) {
+		_, err := stmt.Exec(tx)
+		require.NoError(t, err)
+
+		var links []model.Link
+
+		err = SELECT(Link.AllColumns).
+			FROM(Link).
+			WHERE(Link.ID.EQ(Int32(randId))).
+			Query(tx, &links)
+
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		require.Equal(t, "Google", links[0].Name)
+	})
+}
@@ -0,0 +1,136 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/go-jet/jet/v2/internal/testutils"
+	. "github.com/go-jet/jet/v2/mysql"
+	. "github.com/go-jet/jet/v2/tests/.gentestdata/mysql/test_sample/table"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJsonOperatorsAndFunctions(t *testing.T) {
+	if sourceIsMariaDB() {
+		t.Skip("MariaDB does not support JSON column path operators")
+	}
+
+	stmt := SELECT(
+		AllTypes.JSON.EXTRACT("$.key1").AS("extract"),
+		AllTypes.JSON.EXTRACT_TEXT("$.key1").AS("extract_text"),
+		JSON_UNQUOTE(JSON_EXTRACT(AllTypes.JSON, "$.key2")).AS("unquote"),
+		JSON_SET(AllTypes.JSON, "$.key3", String("value3")).AS("set"),
+		AllTypes.JSON.CONTAINS(Json(map[string]string{"key1": "value1"})).AS("contains"),
+		MEMBER_OF(String("value1"), JSON_EXTRACT(AllTypes.JSON, "$.*")).AS("member_of"),
+	).FROM(
+		AllTypes,
+	).LIMIT(1)
+
+	testutils.AssertDebugStatementSql(t, stmt, `
+SELECT (all_types.json -> '$.key1') AS "extract",
+     (all_types.json ->> '$.key1') AS "extract_text",
+     JSON_UNQUOTE(JSON_EXTRACT(all_types.json, '$.key2')) AS "unquote",
+     JSON_SET(all_types.json, '$.key3', 'value3') AS "set",
+     JSON_CONTAINS(all_types.json, CAST('{"key1":"value1"}' AS JSON)) AS "contains",
+     ('value1' MEMBER OF (JSON_EXTRACT(all_types.json, '$.*'))) AS "member_of"
+FROM test_sample.all_types
+LIMIT 1;
+`)
+
+	var dest struct {
+		Extract     string
+		ExtractText string
+		Unquote     string
+		Set         string
+		Contains    bool
+		MemberOf    bool
+	}
+
+	err := stmt.Query(db, &dest)
+	require.NoError(t, err)
+
+	require.Equal(t, `"value1"`, dest.Extract)
+	require.Equal(t, "value1", dest.ExtractText)
+	require.Equal(t, "value2", dest.Unquote)
+	require.Equal(t, `{"key1": "value1", "key2": "value2", "key3": "value3"}`, dest.Set)
+	require.True(t, dest.Contains)
+	require.True(t, dest.MemberOf)
+}
+
+func TestJsonAggregates(t *testing.T) {
+	stmt := SELECT(
+		JSON_ARRAYAGG(AllTypes.Integer).AS("integers"),
+		JSON_OBJECTAGG(AllTypes.Text, AllTypes.Integer).AS("objects"),
+	).FROM(
+		AllTypes,
+	)
+
+	var dest struct {
+		Integers string
+		Objects  string
+	}
+
+	err := stmt.Query(db, &dest)
+	require.NoError(t, err)
+	require.NotEmpty(t, dest.Integers)
+	require.NotEmpty(t, dest.Objects)
+}
+
+func TestJsonTable(t *testing.T) {
+	if sourceIsMariaDB() {
+		t.Skip("MariaDB JSON_TABLE requires version 10.6")
+	}
+
+	rowID, id, name, tag := IntegerColumn("row_id"), IntegerColumn("id"), StringColumn("name"), StringColumn("tag")
+
+	items := JSON_TABLE(
+		Json(`[{"id": 1, "name": "a"}, {"id": 2, "name": "b", "tags": ["x", "y"]}]`),
+		"$[*]",
+		FOR_ORDINALITY(rowID),
+		PATH(id, "INT", "$.id"),
+		PATH(name, "VARCHAR(10)", "$.name"),
+		NESTED_PATH("$.tags[*]",
+			PATH(tag, "VARCHAR(10)", "$"),
+		),
+	).AS("items")
+
+	stmt := SELECT(
+		items.AllColumns(),
+	).FROM(
+		items,
+	).ORDER_BY(
+		rowID.From(items), tag.From(items),
+	)
+
+	testutils.AssertDebugStatementSql(t, stmt, `
+SELECT items.row_id AS "row_id",
+     items.id AS "id",
+     items.name AS "name",
+     items.tag AS "tag"
+FROM JSON_TABLE(CAST('[{"id": 1, "name": "a"}, {"id": 2, "name": "b", "tags": ["x", "y"]}]' AS JSON), '$[*]' COLUMNS (
+          row_id FOR ORDINALITY,
+          id INT PATH '$.id',
+          name VARCHAR(10) PATH '$.name',
+          NESTED PATH '$.tags[*]' COLUMNS (
+               tag VARCHAR(10) PATH '$'
+          )
+     )) AS items
+ORDER BY items.row_id, items.tag;
+`)
+
+	var dest []struct {
+		RowID int64
+		ID    int64
+		Name  string
+		Tag   *string
+	}
+
+	err := stmt.Query(db, &dest)
+	require.NoError(t, err)
+
+	require.Len(t, dest, 3)
+	require.Equal(t, int64(1), dest[0].RowID)
+	require.Equal(t, "a", dest[0].Name)
+	require.Nil(t, dest[0].Tag)
+	require.Equal(t, int64(2), dest[2].ID)
+	require.Equal(t, testutils.StringPtr("y"), dest[2].Tag)
+}
@@ -2,6 +2,7 @@ package mysql
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -1320,3 +1321,79 @@ FROM dvds.actor;
 	require.NoError(t, err)
 	require.Len(t, actors, 200)
 }
+
+func TestSelectQueryWithCount(t *testing.T) {
+	stmt := SELECT(Actor.AllColumns).
+		FROM(Actor).
+		ORDER_BY(Actor.ActorID.ASC()).
+		LIMIT(10).
+		OFFSET(20)
+
+	var actors []model.Actor
+
+	totalCount, err := stmt.QueryWithCount(context.Background(), db, &actors)
+
+	require.NoError(t, err)
+	require.Len(t, actors, 10)
+	require.EqualValues(t, 21, actors[0].ActorID)
+	require.Equal(t, int64(200), totalCount)
+}
+
+func TestSelectJSONNestedStatement(t *testing.T) {
+	if sourceIsMariaDB() {
+		t.Skip("MariaDB does not preserve JSON type of the nested JSON aggregation sub-queries")
+	}
+
+	stmt := SELECT(
+		Film.AllColumns,
+		Language.AllColumns,
+		Actor.AllColumns,
+	).FROM(
+		Film.
+			INNER_JOIN(Language, Language.LanguageID.EQ(Film.LanguageID)).
+			INNER_JOIN(FilmActor, FilmActor.FilmID.EQ(Film.FilmID)).
+			INNER_JOIN(Actor, Actor.ActorID.EQ(FilmActor.ActorID)),
+	).WHERE(
+		Film.FilmID.LT_EQ(Int(3)),
+	).ORDER_BY(
+		Film.FilmID,
+		Actor.ActorID,
+	)
+
+	var joinDest []struct {
+		model.Film
+		Language model.Language
+		Actors   []model.Actor
+	}
+
+	err := stmt.Query(db, &joinDest)
+	require.NoError(t, err)
+	require.Len(t, joinDest, 3)
+
+	var jsonDest []struct {
+		model.Film
+		Language model.Language
+		Actors   []model.Actor
+	}
+
+	err = stmt.JSONNestedStatement(&jsonDest).Query(db, &jsonDest)
+	require.NoError(t, err)
+	require.Len(t, jsonDest, 3)
+
+	for i := range joinDest {
+		// JSON_ARRAYAGG does not preserve the order of aggregated rows
+		sort.Slice(jsonDest[i].Actors, func(a, b int) bool {
+			return jsonDest[i].Actors[a].ActorID < jsonDest[i].Actors[b].ActorID
+		})
+
+		require.Equal(t, joinDest[i].Film.Title, jsonDest[i].Film.Title)
+		require.Equal(t, joinDest[i].Language.LanguageID, jsonDest[i].Language.LanguageID)
+		require.Len(t, jsonDest[i].Actors, len(joinDest[i].Actors))
+
+		for j := range joinDest[i].Actors {
+			require.Equal(t, joinDest[i].Actors[j].ActorID, jsonDest[i].Actors[j].ActorID)
+			require.Equal(t, joinDest[i].Actors[j].FirstName, jsonDest[i].Actors[j].FirstName)
+			require.Equal(t, joinDest[i].Actors[j].LastUpdate.Unix(), jsonDest[i].Actors[j].LastUpdate.Unix())
+		}
+	}
+}
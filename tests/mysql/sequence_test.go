@@ -0,0 +1,87 @@
+package mysql
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/go-jet/jet/v2/generator/mysql"
+	"github.com/go-jet/jet/v2/internal/testutils"
+	. "github.com/go-jet/jet/v2/mysql"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestSequence(t *testing.T) {
+	if !sourceIsMariaDB() {
+		t.Skip("MySQL does not support sequences")
+	}
+
+	_, err := db.Exec("CREATE SEQUENCE IF NOT EXISTS dvds.jet_test_seq START WITH 100")
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_, err := db.Exec("DROP SEQUENCE IF EXISTS dvds.jet_test_seq")
+		require.NoError(t, err)
+	})
+}
+
+func TestSequenceGenerator(t *testing.T) {
+	createTestSequence(t)
+
+	const sequenceGenTestDir = "./.gentestdata4"
+
+	err := mysql.Generate(sequenceGenTestDir+"/mysql", dbConnection("dvds"))
+	require.NoError(t, err)
+
+	defer os.RemoveAll(sequenceGenTestDir)
+
+	testutils.AssertFileContent(t, sequenceGenTestDir+"/mysql/dvds/sequence/jet_test_seq.go", jetTestSeqSQLBuilderFile)
+}
+
+var jetTestSeqSQLBuilderFile = `
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package sequence
+
+import "github.com/go-jet/jet/v2/mysql"
+
+var JetTestSeq = mysql.NewSequence("dvds", "jet_test_seq")
+`
+
+func TestSequenceNextValCurrValSetVal(t *testing.T) {
+	createTestSequence(t)
+
+	ctx := context.Background()
+	jetTestSeq := NewSequence("dvds", "jet_test_seq")
+
+	var dest struct {
+		Value int64
+	}
+
+	err := SELECT(jetTestSeq.NEXTVAL().AS("value")).QueryContext(ctx, db, &dest)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), dest.Value)
+
+	setValStmt := SELECT(jetTestSeq.SETVAL(Int(200)).AS("value"))
+
+	testutils.AssertStatementSql(t, setValStmt, `
+SELECT SETVAL(dvds.jet_test_seq, 200) AS "value";
+`)
+
+	err = setValStmt.QueryContext(ctx, db, &dest)
+	require.NoError(t, err)
+	require.Equal(t, int64(200), dest.Value)
+
+	err = SELECT(jetTestSeq.NEXTVAL().AS("value")).QueryContext(ctx, db, &dest)
+	require.NoError(t, err)
+	require.Equal(t, int64(201), dest.Value)
+
+	err = SELECT(jetTestSeq.CURRVAL().AS("value")).QueryContext(ctx, db, &dest)
+	require.NoError(t, err)
+	require.Equal(t, int64(201), dest.Value)
+}
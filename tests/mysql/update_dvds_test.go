@@ -17,7 +17,7 @@ func TestUpdateWithJoin(t *testing.T) {
 	testutils.AssertStatementSql(t, statement, `
 UPDATE dvds.staff
 INNER JOIN dvds.address ON (address.address_id = staff.address_id)
-SET last_name = ?
+SET staff.last_name = ?
 WHERE staff.staff_id = ?;
 `, "New staff name", int64(1))
 
@@ -11,6 +11,7 @@ import (
 
 	"github.com/go-jet/jet/v2/internal/testutils"
 	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/postgres/pgrange"
 	"github.com/go-jet/jet/v2/tests/.gentestdata/jetdb/test_sample/model"
 	. "github.com/go-jet/jet/v2/tests/.gentestdata/jetdb/test_sample/table"
 	"github.com/go-jet/jet/v2/tests/.gentestdata/jetdb/test_sample/view"
@@ -30,6 +31,39 @@ func TestAllTypesSelect(t *testing.T) {
 	testutils.AssertDeepEqual(t, dest[1], allTypesRow1)
 }
 
+func TestAllTypesDecodeJSONAndArrays(t *testing.T) {
+	type allTypes struct {
+		Jsonb           map[string]int      `sql:"json"`
+		JsonbPtr        *struct{ A, B int } `sql:"json"`
+		IntegerArray    []int32             `sql:"array"`
+		IntegerArrayPtr *[]int64            `sql:"array"`
+		TextArray       []string            `sql:"array"`
+	}
+
+	var dest []allTypes
+
+	err := SELECT(
+		AllTypes.Jsonb,
+		AllTypes.JsonbPtr,
+		AllTypes.IntegerArray,
+		AllTypes.IntegerArrayPtr,
+		AllTypes.TextArray,
+	).FROM(
+		AllTypes,
+	).LIMIT(2).
+		Query(db, &dest)
+
+	require.NoError(t, err)
+	require.Len(t, dest, 2)
+	require.Equal(t, map[string]int{"a": 1, "b": 3}, dest[0].Jsonb)
+	require.Equal(t, struct{ A, B int }{A: 1, B: 3}, *dest[0].JsonbPtr)
+	require.Equal(t, []int32{1, 2, 3}, dest[0].IntegerArray)
+	require.Equal(t, []int64{1, 2, 3}, *dest[0].IntegerArrayPtr)
+	require.Equal(t, []string{"breakfast", "consulting"}, dest[0].TextArray)
+	require.Nil(t, dest[1].JsonbPtr)
+	require.Nil(t, dest[1].IntegerArrayPtr)
+}
+
 func TestAllTypesViewSelect(t *testing.T) {
 	type AllTypesView model.AllTypes
 
@@ -109,6 +143,41 @@ WHERE all_types.uuid = 'a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11';
 	requireLogged(t, query)
 }
 
+func TestRangeTypes(t *testing.T) {
+	from := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+	to := from.Add(2 * time.Hour)
+
+	seats := INT4RANGE(Int(1), Int(10))
+	period := TSTZRANGE(TimestampzT(from), TimestampzT(to), String("[]"))
+
+	stmt := SELECT(
+		seats.AS("result.seats"),
+		period.AS("result.period"),
+		seats.CONTAINS(Int(5)).AS("result.contains"),
+		seats.UNION(INT4RANGE(Int(10), Int(20))).UPPER_BOUND().AS("result.upper"),
+		period.IS_EMPTY().AS("result.empty"),
+	)
+
+	var dest struct {
+		Seats    pgrange.Int4Range `alias:"result.seats"`
+		Period   pgrange.TsTzRange `alias:"result.period"`
+		Contains bool              `alias:"result.contains"`
+		Upper    int32             `alias:"result.upper"`
+		Empty    bool              `alias:"result.empty"`
+	}
+
+	err := stmt.Query(db, &dest)
+	require.NoError(t, err)
+
+	require.Equal(t, pgrange.Int4Range{Lower: 1, Upper: 10, UpperBound: pgrange.Exclusive}, dest.Seats)
+	require.True(t, dest.Period.Lower.Equal(from))
+	require.True(t, dest.Period.Upper.Equal(to))
+	require.Equal(t, pgrange.Inclusive, dest.Period.UpperBound)
+	require.True(t, dest.Contains)
+	require.Equal(t, int32(20), dest.Upper)
+	require.False(t, dest.Empty)
+}
+
 func TestBytea(t *testing.T) {
 	byteArrHex := "\\x48656c6c6f20476f7068657221"
 	byteArrBin := []byte("\x48\x65\x6c\x6c\x6f\x20\x47\x6f\x70\x68\x65\x72\x21")
@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-jet/jet/v2/internal/testutils"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/tests/.gentestdata/jetdb/dvds/model"
+	. "github.com/go-jet/jet/v2/tests/.gentestdata/jetdb/dvds/table"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorFetchMoveClose(t *testing.T) {
+	ctx := context.Background()
+
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	declare := DECLARE("actor_cursor").CURSOR_FOR(
+		SELECT(Actor.AllColumns).
+			FROM(Actor).
+			ORDER_BY(Actor.ActorID),
+	).SCROLL()
+
+	testutils.AssertDebugStatementSql(t, declare, `
+DECLARE actor_cursor SCROLL CURSOR FOR
+SELECT actor.actor_id AS "actor.actor_id",
+     actor.first_name AS "actor.first_name",
+     actor.last_name AS "actor.last_name",
+     actor.last_update AS "actor.last_update"
+FROM dvds.actor
+ORDER BY actor.actor_id;
+`)
+
+	_, err = declare.ExecContext(ctx, tx)
+	require.NoError(t, err)
+
+	actors, err := QueryAll[model.Actor](ctx, FETCH(3).FROM("actor_cursor"), tx)
+	require.NoError(t, err)
+	require.Len(t, actors, 3)
+	require.Equal(t, int32(1), actors[0].ActorID)
+	require.Equal(t, int32(3), actors[2].ActorID)
+
+	_, err = MOVE(10).FROM("actor_cursor").ExecContext(ctx, tx)
+	require.NoError(t, err)
+
+	actor, err := QueryOne[model.Actor](ctx, FETCH(1).FROM("actor_cursor"), tx)
+	require.NoError(t, err)
+	require.Equal(t, int32(14), actor.ActorID)
+
+	actor, err = QueryOne[model.Actor](ctx, FETCH(-1).FROM("actor_cursor"), tx)
+	require.NoError(t, err)
+	require.Equal(t, int32(13), actor.ActorID)
+
+	_, err = CLOSE("actor_cursor").ExecContext(ctx, tx)
+	require.NoError(t, err)
+}
+
+func TestFetchBatches(t *testing.T) {
+	ctx := context.Background()
+
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	cursor := DECLARE("film_cursor").CURSOR_FOR(
+		SELECT(Film.FilmID, Film.Title).
+			FROM(Film).
+			ORDER_BY(Film.FilmID),
+	)
+
+	var batchSizes []int
+	var lastFilmID int32
+
+	err = FetchBatches(ctx, tx, cursor, 300, func(films []model.Film) error {
+		batchSizes = append(batchSizes, len(films))
+
+		for _, film := range films {
+			require.Greater(t, film.FilmID, lastFilmID)
+			lastFilmID = film.FilmID
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{300, 300, 300, 100}, batchSizes)
+	require.Equal(t, int32(1000), lastFilmID)
+}
@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-jet/jet/v2/internal/testutils"
+	. "github.com/go-jet/jet/v2/postgres"
+	. "github.com/go-jet/jet/v2/tests/.gentestdata/jetdb/dvds/table"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplainQueryPlan(t *testing.T) {
+	skipForCockroachDB(t) // different EXPLAIN output
+
+	stmt := EXPLAIN(
+		SELECT(
+			Film.FilmID,
+			Film.Title,
+		).FROM(
+			Film,
+		).WHERE(
+			Film.Title.EQ(String("Ace Goldfinger")),
+		),
+		ExplainOptions{Analyze: true, Buffers: true},
+	)
+
+	testutils.AssertDebugStatementSql(t, stmt, `
+EXPLAIN (ANALYZE, BUFFERS, FORMAT JSON)
+SELECT film.film_id AS "film.film_id",
+     film.title AS "film.title"
+FROM dvds.film
+WHERE film.title = 'Ace Goldfinger'::text;
+`)
+
+	plan, err := stmt.QueryPlan(context.Background(), db)
+	require.NoError(t, err)
+	require.True(t, plan.UsesIndex("idx_title"))
+
+	indexScans := plan.Find(func(node PlanNode) bool {
+		return node.Index == "idx_title"
+	})
+	require.Len(t, indexScans, 1)
+	require.Equal(t, "film", indexScans[0].Relation)
+	require.NotNil(t, indexScans[0].ActualRows)
+	require.Equal(t, float64(1), *indexScans[0].ActualRows)
+}
@@ -248,6 +248,10 @@ func assertGeneratedFiles(t *testing.T) {
 	testutils.AssertFileNamesEqual(t, "./.gentestdata2/jetdb/dvds/enum", "mpaa_rating.go")
 	testutils.AssertFileContent(t, "./.gentestdata2/jetdb/dvds/enum/mpaa_rating.go", mpaaRatingEnumFile)
 
+	// Sequence SQL Builder files
+	file.Exists(t, "./.gentestdata2/jetdb/dvds/sequence", "sequence_use_schema.go")
+	testutils.AssertFileContent(t, "./.gentestdata2/jetdb/dvds/sequence/film_film_id_seq.go", filmFilmIDSeqSQLBuilderFile)
+
 	// Model files
 	testutils.AssertFileNamesEqual(t, "./.gentestdata2/jetdb/dvds/model", "actor.go", "address.go", "category.go", "city.go", "country.go",
 		"customer.go", "film.go", "film_actor.go", "film_category.go", "inventory.go", "language.go",
@@ -355,10 +359,11 @@ func newActorTableImpl(schemaName, tableName, alias string) actorTable {
 		LastUpdateColumn = postgres.TimestampColumn("last_update")
 		allColumns       = postgres.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, LastUpdateColumn}
 		mutableColumns   = postgres.ColumnList{FirstNameColumn, LastNameColumn, LastUpdateColumn}
+		primaryKeys      = postgres.ColumnList{ActorIDColumn}
 	)
 
 	return actorTable{
-		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+		Table: postgres.NewTableWithPrimaryKey(schemaName, tableName, alias, primaryKeys, allColumns...),
 
 		//Columns
 		ActorID:    ActorIDColumn,
@@ -372,6 +377,21 @@ func newActorTableImpl(schemaName, tableName, alias string) actorTable {
 }
 `
 
+var filmFilmIDSeqSQLBuilderFile = `
+//
+// Code generated by go-jet DO NOT EDIT.
+//
+// WARNING: Changes to this file may cause incorrect behavior
+// and will be lost if the code is regenerated
+//
+
+package sequence
+
+import "github.com/go-jet/jet/v2/postgres"
+
+var FilmFilmIDSeq = postgres.NewSequence("dvds", "film_film_id_seq")
+`
+
 var tableUseSchemaFile = `
 //
 // Code generated by go-jet DO NOT EDIT.
@@ -495,10 +515,11 @@ func newActorInfoTableImpl(schemaName, tableName, alias string) actorInfoTable {
 		FilmInfoColumn  = postgres.StringColumn("film_info")
 		allColumns      = postgres.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, FilmInfoColumn}
 		mutableColumns  = postgres.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, FilmInfoColumn}
+		primaryKeys     = postgres.ColumnList{}
 	)
 
 	return actorInfoTable{
-		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+		Table: postgres.NewTableWithPrimaryKey(schemaName, tableName, alias, primaryKeys, allColumns...),
 
 		//Columns
 		ActorID:   ActorIDColumn,
@@ -751,8 +772,8 @@ type allTypesTable struct {
 	Bit                  postgres.ColumnString
 	BitVaryingPtr        postgres.ColumnString
 	BitVarying           postgres.ColumnString
-	TsvectorPtr          postgres.ColumnString
-	Tsvector             postgres.ColumnString
+	TsvectorPtr          postgres.ColumnTsVector
+	Tsvector             postgres.ColumnTsVector
 	UUIDPtr              postgres.ColumnString
 	UUID                 postgres.ColumnString
 	XMLPtr               postgres.ColumnString
@@ -852,8 +873,8 @@ func newAllTypesTableImpl(schemaName, tableName, alias string) allTypesTable {
 		BitColumn                  = postgres.StringColumn("bit")
 		BitVaryingPtrColumn        = postgres.StringColumn("bit_varying_ptr")
 		BitVaryingColumn           = postgres.StringColumn("bit_varying")
-		TsvectorPtrColumn          = postgres.StringColumn("tsvector_ptr")
-		TsvectorColumn             = postgres.StringColumn("tsvector")
+		TsvectorPtrColumn          = postgres.TsVectorColumn("tsvector_ptr")
+		TsvectorColumn             = postgres.TsVectorColumn("tsvector")
 		UUIDPtrColumn              = postgres.StringColumn("uuid_ptr")
 		UUIDColumn                 = postgres.StringColumn("uuid")
 		XMLPtrColumn               = postgres.StringColumn("xml_ptr")
@@ -871,10 +892,11 @@ func newAllTypesTableImpl(schemaName, tableName, alias string) allTypesTable {
 		TextMultiDimArrayColumn    = postgres.StringColumn("text_multi_dim_array")
 		allColumns                 = postgres.ColumnList{SmallIntPtrColumn, SmallIntColumn, IntegerPtrColumn, IntegerColumn, BigIntPtrColumn, BigIntColumn, DecimalPtrColumn, DecimalColumn, NumericPtrColumn, NumericColumn, RealPtrColumn, RealColumn, DoublePrecisionPtrColumn, DoublePrecisionColumn, SmallserialColumn, SerialColumn, BigserialColumn, VarCharPtrColumn, VarCharColumn, CharPtrColumn, CharColumn, TextPtrColumn, TextColumn, ByteaPtrColumn, ByteaColumn, TimestampzPtrColumn, TimestampzColumn, TimestampPtrColumn, TimestampColumn, DatePtrColumn, DateColumn, TimezPtrColumn, TimezColumn, TimePtrColumn, TimeColumn, IntervalPtrColumn, IntervalColumn, BooleanPtrColumn, BooleanColumn, PointPtrColumn, BitPtrColumn, BitColumn, BitVaryingPtrColumn, BitVaryingColumn, TsvectorPtrColumn, TsvectorColumn, UUIDPtrColumn, UUIDColumn, XMLPtrColumn, XMLColumn, JSONPtrColumn, JSONColumn, JsonbPtrColumn, JsonbColumn, IntegerArrayPtrColumn, IntegerArrayColumn, TextArrayPtrColumn, TextArrayColumn, JsonbArrayColumn, TextMultiDimArrayPtrColumn, TextMultiDimArrayColumn}
 		mutableColumns             = postgres.ColumnList{SmallIntPtrColumn, SmallIntColumn, IntegerPtrColumn, IntegerColumn, BigIntPtrColumn, BigIntColumn, DecimalPtrColumn, DecimalColumn, NumericPtrColumn, NumericColumn, RealPtrColumn, RealColumn, DoublePrecisionPtrColumn, DoublePrecisionColumn, SmallserialColumn, SerialColumn, BigserialColumn, VarCharPtrColumn, VarCharColumn, CharPtrColumn, CharColumn, TextPtrColumn, TextColumn, ByteaPtrColumn, ByteaColumn, TimestampzPtrColumn, TimestampzColumn, TimestampPtrColumn, TimestampColumn, DatePtrColumn, DateColumn, TimezPtrColumn, TimezColumn, TimePtrColumn, TimeColumn, IntervalPtrColumn, IntervalColumn, BooleanPtrColumn, BooleanColumn, PointPtrColumn, BitPtrColumn, BitColumn, BitVaryingPtrColumn, BitVaryingColumn, TsvectorPtrColumn, TsvectorColumn, UUIDPtrColumn, UUIDColumn, XMLPtrColumn, XMLColumn, JSONPtrColumn, JSONColumn, JsonbPtrColumn, JsonbColumn, IntegerArrayPtrColumn, IntegerArrayColumn, TextArrayPtrColumn, TextArrayColumn, JsonbArrayColumn, TextMultiDimArrayPtrColumn, TextMultiDimArrayColumn}
+		primaryKeys                = postgres.ColumnList{}
 	)
 
 	return allTypesTable{
-		Table: postgres.NewTable(schemaName, tableName, alias, allColumns...),
+		Table: postgres.NewTableWithPrimaryKey(schemaName, tableName, alias, primaryKeys, allColumns...),
 
 		//Columns
 		SmallIntPtr:          SmallIntPtrColumn,
@@ -207,6 +207,25 @@ ON CONFLICT (id) WHERE (id * 2) > 10 DO UPDATE
 
 		testutils.AssertExecAndRollback(t, stmt, db, 1)
 	})
+
+	t.Run("on primary key do update all", func(t *testing.T) {
+		stmt := Link.INSERT(Link.ID, Link.URL, Link.Name, Link.Description).
+			VALUES(100, "http://www.postgresqltutorial.com", "PostgreSQL Tutorial", DEFAULT).
+			VALUES(200, "http://www.postgresqltutorial.com", "PostgreSQL Tutorial", DEFAULT).
+			ON_CONFLICT_PRIMARY_KEY().DO_UPDATE_ALL()
+
+		testutils.AssertStatementSql(t, stmt, `
+INSERT INTO test_sample.link (id, url, name, description)
+VALUES ($1, $2, $3, DEFAULT),
+       ($4, $5, $6, DEFAULT)
+ON CONFLICT (id) DO UPDATE
+       SET url = excluded.url,
+           name = excluded.name,
+           description = excluded.description;
+`)
+
+		testutils.AssertExecAndRollback(t, stmt, db, 2)
+	})
 }
 
 func TestInsertModelObject(t *testing.T) {
@@ -39,16 +39,12 @@ func TestScanToInvalidDestination(t *testing.T) {
 		testutils.AssertQueryPanicErr(t, oneInventoryQuery, db, []**struct{}{}, "jet: destination has to be a pointer to slice or pointer to struct")
 	})
 
-	t.Run("map dest", func(t *testing.T) {
-		testutils.AssertQueryPanicErr(t, oneInventoryQuery, db, &map[string]string{}, "jet: destination has to be a pointer to slice or pointer to struct")
-	})
-
 	t.Run("map dest", func(t *testing.T) {
 		testutils.AssertQueryPanicErr(t, oneInventoryQuery, db, []map[string]string{}, "jet: destination has to be a pointer to slice or pointer to struct")
 	})
 
-	t.Run("map dest", func(t *testing.T) {
-		testutils.AssertQueryPanicErr(t, oneInventoryQuery, db, &[]map[string]string{}, "jet: unsupported slice element type")
+	t.Run("map of unsupported value type", func(t *testing.T) {
+		testutils.AssertQueryPanicErr(t, oneInventoryQuery, db, &map[int][]int{}, "jet: unsupported map value type")
 	})
 }
 
@@ -96,6 +92,23 @@ func TestScanToValidDestination(t *testing.T) {
 		require.Equal(t, dest[0], testutils.Int32Ptr(1))
 	})
 
+	t.Run("pointer to map", func(t *testing.T) {
+		dest := map[string]interface{}{}
+
+		err := oneInventoryQuery.Query(db, &dest)
+		require.NoError(t, err)
+		require.Equal(t, dest["inventory.inventory_id"], int64(1))
+	})
+
+	t.Run("pointer to slice of maps", func(t *testing.T) {
+		var dest []map[string]interface{}
+
+		err := oneInventoryQuery.Query(db, &dest)
+		require.NoError(t, err)
+		require.Len(t, dest, 1)
+		require.Equal(t, dest[0]["inventory.film_id"], int64(1))
+	})
+
 	t.Run("NULL to integer", func(t *testing.T) {
 		var dest struct {
 			Int64  int64
@@ -719,6 +732,130 @@ func TestScanToSlice(t *testing.T) {
 	})
 }
 
+func TestScanToMap(t *testing.T) {
+	query := Inventory.
+		INNER_JOIN(Film, Inventory.FilmID.EQ(Film.FilmID)).
+		SELECT(
+			Inventory.AllColumns,
+			Film.AllColumns,
+		).
+		WHERE(Inventory.InventoryID.LT_EQ(Int(10))).
+		ORDER_BY(Inventory.InventoryID)
+
+	t.Run("map of structs", func(t *testing.T) {
+		var dest map[int32]model.Inventory
+
+		err := query.Query(db, &dest)
+		require.NoError(t, err)
+		require.Len(t, dest, 10)
+		testutils.AssertDeepEqual(t, dest[1], inventory1)
+		testutils.AssertDeepEqual(t, dest[2], inventory2)
+	})
+
+	t.Run("map of complex structs", func(t *testing.T) {
+		var dest map[int32]*struct {
+			model.Film
+
+			Inventories map[int32]model.Inventory
+		}
+
+		err := query.Query(db, &dest)
+		require.NoError(t, err)
+		require.Len(t, dest, 2)
+		testutils.AssertDeepEqual(t, dest[1].Film, film1)
+		require.Len(t, dest[1].Inventories, 8)
+		testutils.AssertDeepEqual(t, dest[1].Inventories[1], inventory1)
+		require.Len(t, dest[2].Inventories, 2)
+	})
+
+	t.Run("map key tag", func(t *testing.T) {
+		var dest struct {
+			InventoriesByStore map[int16][]model.Inventory `sql:"map_key=StoreID"`
+		}
+
+		err := query.Query(db, &dest)
+		require.NoError(t, err)
+		require.Len(t, dest.InventoriesByStore, 2)
+		require.Len(t, dest.InventoriesByStore[1], 4)
+		require.Len(t, dest.InventoriesByStore[2], 6)
+	})
+}
+
+func TestScanStrict(t *testing.T) {
+	stmt := SELECT(
+		Film.FilmID.AS("film.film_id"),
+		Film.Title.AS("film.titel"),
+	).FROM(
+		Film,
+	).WHERE(
+		Film.FilmID.EQ(Int(1)),
+	)
+
+	type film struct {
+		FilmID int32 `sql:"primary_key"`
+		Title  string
+	}
+
+	var dest []film
+
+	err := stmt.QueryContext(qrm.WithStrictScan(context.Background(), true), db, &dest)
+	require.EqualError(t, err, "jet: strict scan: columns not mapped to any destination field: film.titel; "+
+		"destination fields without matching column: Title")
+}
+
+func TestScanJSONArrayAggregation(t *testing.T) {
+	stmt := SELECT(
+		Film.AllColumns,
+		SELECT_JSON_ARR(Actor.AllColumns).
+			FROM(
+				Actor.
+					INNER_JOIN(FilmActor, FilmActor.ActorID.EQ(Actor.ActorID)),
+			).
+			WHERE(FilmActor.FilmID.EQ(Film.FilmID)).
+			ORDER_BY(Actor.ActorID).AS("actors"),
+	).FROM(
+		Film,
+	).WHERE(
+		Film.FilmID.LT_EQ(Int(2)),
+	).ORDER_BY(
+		Film.FilmID,
+	)
+
+	var jsonDest []struct {
+		model.Film
+		Actors []model.Actor
+	}
+
+	err := stmt.Query(db, &jsonDest)
+	require.NoError(t, err)
+
+	var joinDest []struct {
+		model.Film
+		Actors []model.Actor
+	}
+
+	err = SELECT(
+		Film.AllColumns,
+		Actor.AllColumns,
+	).FROM(
+		Film.
+			INNER_JOIN(FilmActor, FilmActor.FilmID.EQ(Film.FilmID)).
+			INNER_JOIN(Actor, Actor.ActorID.EQ(FilmActor.ActorID)),
+	).WHERE(
+		Film.FilmID.LT_EQ(Int(2)),
+	).ORDER_BY(
+		Film.FilmID,
+		Actor.ActorID,
+	).Query(db, &joinDest)
+
+	require.NoError(t, err)
+	require.Len(t, jsonDest, 2)
+	require.Equal(t, joinDest[0].Film, jsonDest[0].Film)
+	require.Equal(t, len(joinDest[0].Actors), len(jsonDest[0].Actors))
+	require.Equal(t, joinDest[1].Actors[0].ActorID, jsonDest[1].Actors[0].ActorID)
+	require.Equal(t, joinDest[1].Actors[0].LastUpdate.Unix(), jsonDest[1].Actors[0].LastUpdate.Unix())
+}
+
 func TestStructScanErrNoRows(t *testing.T) {
 	query := SELECT(Customer.AllColumns).
 		FROM(Customer).
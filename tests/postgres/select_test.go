@@ -2251,6 +2251,40 @@ FOR`
 	}
 }
 
+func TestRowLockOf(t *testing.T) {
+	query := SELECT(
+		Address.AddressID,
+		City.City,
+	).FROM(
+		Address.INNER_JOIN(City, City.CityID.EQ(Address.CityID)),
+	).ORDER_BY(
+		Address.AddressID,
+	).LIMIT(3).FOR(
+		UPDATE().OF(Address).SKIP_LOCKED(),
+		KEY_SHARE().OF(City),
+	)
+
+	testutils.AssertDebugStatementSql(t, query, `
+SELECT address.address_id AS "address.address_id",
+     city.city AS "city.city"
+FROM dvds.address
+     INNER JOIN dvds.city ON (city.city_id = address.city_id)
+ORDER BY address.address_id
+LIMIT 3
+FOR UPDATE OF address SKIP LOCKED
+FOR KEY SHARE OF city;
+`)
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	res, err := query.Exec(tx)
+	require.NoError(t, err)
+	rowsAffected, _ := res.RowsAffected()
+	require.Equal(t, int64(3), rowsAffected)
+}
+
 func TestQuickStart(t *testing.T) {
 
 	var expectedSQL = `
@@ -3459,3 +3493,118 @@ var lastCustomer = model.Customer{
 	LastUpdate: testutils.TimestampWithoutTimeZone("2013-05-26 14:49:45.738", 3),
 	Active:     testutils.Int32Ptr(1),
 }
+
+func TestSelectQueryWithCount(t *testing.T) {
+	stmt := SELECT(Actor.AllColumns).
+		FROM(Actor).
+		ORDER_BY(Actor.ActorID.ASC()).
+		LIMIT(10).
+		OFFSET(20)
+
+	var actors []model.Actor
+
+	totalCount, err := stmt.QueryWithCount(context.Background(), db, &actors)
+
+	require.NoError(t, err)
+	require.Len(t, actors, 10)
+	require.Equal(t, int32(21), actors[0].ActorID)
+	require.Equal(t, int64(200), totalCount)
+}
+
+func TestSelectFullTextSearch(t *testing.T) {
+	query := WEBSEARCH_TO_TSQUERY(String("dentist -australia"), "english")
+
+	stmt := SELECT(
+		Film.FilmID,
+		Film.Title,
+		TS_RANK(Film.Fulltext, query).AS("rank"),
+		TS_HEADLINE(Film.Description, query).AS("headline"),
+	).FROM(
+		Film,
+	).WHERE(
+		Film.Fulltext.MATCH(query),
+	).ORDER_BY(
+		TS_RANK(Film.Fulltext, query).DESC(),
+		Film.FilmID,
+	).LIMIT(5)
+
+	testutils.AssertDebugStatementSql(t, stmt, `
+SELECT film.film_id AS "film.film_id",
+     film.title AS "film.title",
+     ts_rank(film.fulltext, websearch_to_tsquery('english'::regconfig, 'dentist -australia'::text)) AS "rank",
+     ts_headline(film.description, websearch_to_tsquery('english'::regconfig, 'dentist -australia'::text)) AS "headline"
+FROM dvds.film
+WHERE film.fulltext @@ websearch_to_tsquery('english'::regconfig, 'dentist -australia'::text)
+ORDER BY ts_rank(film.fulltext, websearch_to_tsquery('english'::regconfig, 'dentist -australia'::text)) DESC, film.film_id
+LIMIT 5;
+`)
+
+	var dest []struct {
+		model.Film
+
+		Rank     float64
+		Headline string
+	}
+
+	err := stmt.Query(db, &dest)
+	require.NoError(t, err)
+	require.Len(t, dest, 5)
+
+	for i, film := range dest {
+		require.Contains(t, film.Headline, "<b>Dentist</b>")
+
+		if i > 0 {
+			require.LessOrEqual(t, film.Rank, dest[i-1].Rank)
+		}
+	}
+}
+
+func TestSelectFetchFirstWithTies(t *testing.T) {
+	stmt := SELECT(
+		Film.FilmID,
+		Film.RentalRate,
+	).FROM(
+		Film,
+	).ORDER_BY(
+		Film.RentalRate.DESC(),
+	).FETCH_FIRST(1).WITH_TIES()
+
+	testutils.AssertDebugStatementSql(t, stmt, `
+SELECT film.film_id AS "film.film_id",
+     film.rental_rate AS "film.rental_rate"
+FROM dvds.film
+ORDER BY film.rental_rate DESC
+FETCH FIRST 1 ROWS WITH TIES;
+`)
+
+	var dest []model.Film
+
+	err := stmt.Query(db, &dest)
+	require.NoError(t, err)
+	require.Greater(t, len(dest), 1)
+
+	for _, film := range dest {
+		require.Equal(t, dest[0].RentalRate, film.RentalRate)
+	}
+}
+
+func TestSelectTableSample(t *testing.T) {
+	stmt := SELECT(
+		COUNT(STAR).AS("count"),
+	).FROM(
+		Film.TABLESAMPLE(BERNOULLI, Int(100)).REPEATABLE(Int(42)),
+	)
+
+	testutils.AssertDebugStatementSql(t, stmt, `
+SELECT COUNT(*) AS "count"
+FROM dvds.film TABLESAMPLE BERNOULLI (100) REPEATABLE (42);
+`)
+
+	var dest struct {
+		Count int64
+	}
+
+	err := stmt.Query(db, &dest)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), dest.Count)
+}
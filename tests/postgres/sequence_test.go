@@ -0,0 +1,48 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-jet/jet/v2/internal/testutils"
+	. "github.com/go-jet/jet/v2/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSequenceNextValCurrValSetVal(t *testing.T) {
+	ctx := context.Background()
+
+	tx, err := db.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	filmFilmIDSeq := NewSequence("dvds", "film_film_id_seq")
+
+	var dest struct {
+		Value int64
+	}
+
+	err = SELECT(filmFilmIDSeq.NEXTVAL().AS("value")).QueryContext(ctx, tx, &dest)
+	require.NoError(t, err)
+
+	nextVal := dest.Value
+
+	// sequence changes are not rolled back, so the sequence is set to the value it already has
+	setValStmt := SELECT(filmFilmIDSeq.SETVAL(Int(nextVal)).AS("value"))
+
+	testutils.AssertStatementSql(t, setValStmt, `
+SELECT setval('dvds.film_film_id_seq', $1) AS "value";
+`, nextVal)
+
+	err = setValStmt.QueryContext(ctx, tx, &dest)
+	require.NoError(t, err)
+	require.Equal(t, nextVal, dest.Value)
+
+	err = SELECT(filmFilmIDSeq.NEXTVAL().AS("value")).QueryContext(ctx, tx, &dest)
+	require.NoError(t, err)
+	require.Equal(t, nextVal+1, dest.Value)
+
+	err = SELECT(filmFilmIDSeq.CURRVAL().AS("value")).QueryContext(ctx, tx, &dest)
+	require.NoError(t, err)
+	require.Equal(t, nextVal+1, dest.Value)
+}
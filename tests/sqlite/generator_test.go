@@ -195,10 +195,11 @@ func newActorTableImpl(schemaName, tableName, alias string) actorTable {
 		LastUpdateColumn = sqlite.TimestampColumn("last_update")
 		allColumns       = sqlite.ColumnList{ActorIDColumn, FirstNameColumn, LastNameColumn, LastUpdateColumn}
 		mutableColumns   = sqlite.ColumnList{FirstNameColumn, LastNameColumn, LastUpdateColumn}
+		primaryKeys      = sqlite.ColumnList{ActorIDColumn}
 	)
 
 	return actorTable{
-		Table: sqlite.NewTable(schemaName, tableName, alias, allColumns...),
+		Table: sqlite.NewTableWithPrimaryKey(schemaName, tableName, alias, primaryKeys, allColumns...),
 
 		//Columns
 		ActorID:    ActorIDColumn,
@@ -321,10 +322,11 @@ func newFilmListTableImpl(schemaName, tableName, alias string) filmListTable {
 		ActorsColumn      = sqlite.StringColumn("actors")
 		allColumns        = sqlite.ColumnList{FidColumn, TitleColumn, DescriptionColumn, CategoryColumn, PriceColumn, LengthColumn, RatingColumn, ActorsColumn}
 		mutableColumns    = sqlite.ColumnList{FidColumn, TitleColumn, DescriptionColumn, CategoryColumn, PriceColumn, LengthColumn, RatingColumn, ActorsColumn}
+		primaryKeys       = sqlite.ColumnList{}
 	)
 
 	return filmListTable{
-		Table: sqlite.NewTable(schemaName, tableName, alias, allColumns...),
+		Table: sqlite.NewTableWithPrimaryKey(schemaName, tableName, alias, primaryKeys, allColumns...),
 
 		//Columns
 		Fid:         FidColumn,
@@ -912,3 +912,20 @@ ORDER BY film.film_id;
 ]
 `)
 }
+
+func TestSelectQueryWithCount(t *testing.T) {
+	stmt := SELECT(Actor.AllColumns).
+		FROM(Actor).
+		ORDER_BY(Actor.ActorID.ASC()).
+		LIMIT(10).
+		OFFSET(20)
+
+	var actors []model.Actor
+
+	totalCount, err := stmt.QueryWithCount(context.Background(), db, &actors)
+
+	require.NoError(t, err)
+	require.Len(t, actors, 10)
+	require.EqualValues(t, 21, actors[0].ActorID)
+	require.Equal(t, int64(200), totalCount)
+}
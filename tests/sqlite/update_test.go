@@ -364,3 +364,51 @@ RETURNING rental.rental_id AS "rental.rental_id",
 ]
 `)
 }
+
+func TestUpdateModels(t *testing.T) {
+	tx := beginSampleDBTx(t)
+	defer tx.Rollback()
+
+	_, err := Link.INSERT(Link.ID, Link.URL, Link.Name).
+		VALUES(100, "http://www.duckduckgo.com", "DuckDuckGo").
+		VALUES(101, "http://www.yahoo.com", "Yahoo").
+		Exec(tx)
+	require.NoError(t, err)
+
+	links := []model.Link{
+		{ID: 100, URL: "http://duckduckgo.com", Name: "Duck"},
+		{ID: 101, URL: "http://yahoo.com", Name: "Yahoo!"},
+	}
+
+	stmt := Link.UPDATE(Link.URL, Link.Name).
+		MODELS(links)
+
+	testutils.AssertDebugStatementSql(t, stmt, `
+WITH v (id, url, name) AS (
+     VALUES (100, 'http://duckduckgo.com', 'Duck'),
+            (101, 'http://yahoo.com', 'Yahoo!')
+)
+UPDATE link
+SET url = v.url,
+    name = v.name
+FROM v
+WHERE link.id = v.id;
+`)
+
+	res, err := stmt.ExecInBatches(context.Background(), tx)
+	require.NoError(t, err)
+	rowsAffected, err := res.RowsAffected()
+	require.NoError(t, err)
+	require.Equal(t, int64(2), rowsAffected)
+
+	var dest []model.Link
+
+	err = SELECT(Link.AllColumns).
+		FROM(Link).
+		WHERE(Link.ID.IN(Int(100), Int(101))).
+		ORDER_BY(Link.ID).
+		Query(tx, &dest)
+
+	require.NoError(t, err)
+	testutils.AssertDeepEqual(t, dest, links)
+}